@@ -0,0 +1,145 @@
+package dough
+
+import (
+	"math/big"
+	"sort"
+)
+
+type remainder string
+
+// Remainder strategies decide who receives the minor units left over once every
+// party has been given its floored share. Parties with a zero ratio never receive any.
+const (
+	// LargestRemainder gives one minor unit each to the parties with the largest fractional shares, earliest first on ties
+	LargestRemainder remainder = "largest"
+	// FirstRemainder gives one minor unit each to the first parties in order
+	FirstRemainder remainder = "first"
+	// LastRemainder gives every leftover minor unit to the last party
+	LastRemainder remainder = "last"
+)
+
+// Allocate : returns the amount divided across the ratios so the parts always sum to the original amount... "amount" being the amount in minor units, "alpha" being the ISO three digit alphabetic code.
+func Allocate(amount int, alpha string, strategy remainder, ratios ...int) ([]int, error) {
+	if _, err := GetISOFromAlpha(alpha); err != nil {
+		return nil, err
+	}
+	r := make([]int64, len(ratios))
+	for key, val := range ratios {
+		r[key] = int64(val)
+	}
+	parts, err := allocate(int64(amount), r, strategy)
+	if err != nil {
+		return nil, err
+	}
+	output := make([]int, len(parts))
+	for key, val := range parts {
+		output[key] = int(val)
+	}
+	return output, nil
+}
+
+// Split : returns the amount divided into n equal parts that sum to the original amount
+//
+// LargestRemainder and FirstRemainder give parts that differ by at most one minor unit, while
+// LastRemainder gives every leftover minor unit to the last part, so Split(5, "USD", 7, LastRemainder)
+// is []int{0, 0, 0, 0, 0, 0, 5}.
+func Split(amount int, alpha string, n int, strategy remainder) ([]int, error) {
+	if n < 1 {
+		return nil, ErrorInvalidAllocation
+	}
+	return Allocate(amount, alpha, strategy, evenRatios(n)...)
+}
+
+// Allocate : returns the amount divided across the ratios so the parts always sum to the original amount
+func (m Money) Allocate(strategy remainder, ratios ...int) ([]Money, error) {
+	r := make([]int64, len(ratios))
+	for key, val := range ratios {
+		r[key] = int64(val)
+	}
+	parts, err := allocate(m.amount, r, strategy)
+	if err != nil {
+		return nil, err
+	}
+	output := make([]Money, len(parts))
+	for key, val := range parts {
+		output[key] = Money{amount: val, currency: m.currency}
+	}
+	return output, nil
+}
+
+// Split : returns the amount divided into n equal parts that sum to the original amount, see Split for how far
+// apart the strategy leaves the parts
+func (m Money) Split(n int, strategy remainder) ([]Money, error) {
+	if n < 1 {
+		return nil, ErrorInvalidAllocation
+	}
+	return m.Allocate(strategy, evenRatios(n)...)
+}
+
+// evenRatios : returns n equal ratios
+func evenRatios(n int) []int {
+	ratios := make([]int, n)
+	for key := range ratios {
+		ratios[key] = 1
+	}
+	return ratios
+}
+
+// allocate : returns the floored share of each ratio with the leftover minor units handed out by strategy
+func allocate(amount int64, ratios []int64, strategy remainder) ([]int64, error) {
+	if len(ratios) == 0 {
+		return nil, ErrorInvalidAllocation
+	}
+	sum := new(big.Int)
+	for _, val := range ratios {
+		if val < 0 {
+			return nil, ErrorInvalidAllocation
+		}
+		sum.Add(sum, big.NewInt(val))
+	}
+	if sum.Sign() == 0 {
+		return nil, ErrorInvalidAllocation
+	}
+
+	// Allocate the absolute amount so flooring always moves toward zero, then restore the sign
+	total := new(big.Int).Abs(big.NewInt(amount))
+	shares := make([]*big.Int, len(ratios))
+	remainders := make([]*big.Int, len(ratios))
+	left := new(big.Int).Set(total)
+	for key, val := range ratios {
+		product := new(big.Int).Mul(total, big.NewInt(val))
+		shares[key], remainders[key] = product.QuoRem(product, sum, new(big.Int))
+		left.Sub(left, shares[key])
+	}
+
+	// left is always smaller than the number of parties with a non-zero ratio
+	var order []int
+	for key, val := range ratios {
+		if val > 0 {
+			order = append(order, key)
+		}
+	}
+	switch strategy {
+	case FirstRemainder:
+	case LastRemainder:
+		last := order[len(order)-1]
+		shares[last].Add(shares[last], left)
+		left.SetInt64(0)
+	default:
+		sort.SliceStable(order, func(i, j int) bool {
+			return remainders[order[i]].Cmp(remainders[order[j]]) > 0
+		})
+	}
+	for _, key := range order[:left.Int64()] {
+		shares[key].Add(shares[key], big.NewInt(1))
+	}
+
+	output := make([]int64, len(shares))
+	for key, val := range shares {
+		if amount < 0 {
+			val.Neg(val)
+		}
+		output[key] = val.Int64()
+	}
+	return output, nil
+}
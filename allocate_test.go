@@ -0,0 +1,141 @@
+package dough
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+var TestAllocateData = []struct {
+	Amount   int
+	Alpha    string
+	Strategy remainder
+	Ratios   []int
+	Output   interface{}
+}{
+	{100, "USA", LargestRemainder, []int{1, 1}, ErrorInvalidISO.Error()},
+	{100, "USD", LargestRemainder, []int{}, ErrorInvalidAllocation.Error()},
+	{100, "USD", LargestRemainder, []int{0, 0}, ErrorInvalidAllocation.Error()},
+	{100, "USD", LargestRemainder, []int{1, -1}, ErrorInvalidAllocation.Error()},
+
+	{100, "USD", LargestRemainder, []int{1, 1, 1}, []int{34, 33, 33}},
+	{100, "USD", FirstRemainder, []int{1, 1, 1}, []int{34, 33, 33}},
+	{100, "USD", LastRemainder, []int{1, 1, 1}, []int{33, 33, 34}},
+	{-100, "USD", LargestRemainder, []int{1, 1, 1}, []int{-34, -33, -33}},
+	{-100, "USD", LastRemainder, []int{1, 1, 1}, []int{-33, -33, -34}},
+
+	// 7 / 10 gives shares 0.7, 2.1, 4.2
+	{7, "USD", LargestRemainder, []int{1, 3, 6}, []int{1, 2, 4}},
+	{7, "USD", FirstRemainder, []int{1, 3, 6}, []int{1, 2, 4}},
+	{7, "USD", LastRemainder, []int{1, 3, 6}, []int{0, 2, 5}},
+
+	// 5 / 6 gives shares 0.83, 1.67, 2.5
+	{5, "USD", LargestRemainder, []int{1, 2, 3}, []int{1, 2, 2}},
+	{5, "USD", FirstRemainder, []int{1, 2, 3}, []int{1, 2, 2}},
+	{5, "USD", LastRemainder, []int{1, 2, 3}, []int{0, 1, 4}},
+
+	// Zero ratios never receive a leftover unit
+	{10, "USD", FirstRemainder, []int{0, 1, 1, 1}, []int{0, 4, 3, 3}},
+	{10, "USD", LastRemainder, []int{1, 1, 1, 0}, []int{3, 3, 4, 0}},
+
+	{0, "USD", LargestRemainder, []int{1, 1}, []int{0, 0}},
+	{1000, "JPY", LargestRemainder, []int{70, 20, 10}, []int{700, 200, 100}},
+	{1999, "BHD", LargestRemainder, []int{50, 50}, []int{1000, 999}},
+}
+
+func TestAllocate(t *testing.T) {
+	for _, v := range TestAllocateData {
+		result, err := Allocate(v.Amount, v.Alpha, v.Strategy, v.Ratios...)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if !reflect.DeepEqual(result, v.Output) {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+var TestSplitData = []struct {
+	Amount   int
+	N        int
+	Strategy remainder
+	Output   interface{}
+}{
+	{100, 0, LargestRemainder, ErrorInvalidAllocation.Error()},
+	{100, 1, LargestRemainder, []int{100}},
+	{100, 3, LargestRemainder, []int{34, 33, 33}},
+	{101, 3, FirstRemainder, []int{34, 34, 33}},
+	{101, 3, LastRemainder, []int{33, 33, 35}},
+	{2, 3, LargestRemainder, []int{1, 1, 0}},
+	{5, 7, LastRemainder, []int{0, 0, 0, 0, 0, 0, 5}},
+	{5, 7, FirstRemainder, []int{1, 1, 1, 1, 1, 0, 0}},
+}
+
+func TestSplit(t *testing.T) {
+	for _, v := range TestSplitData {
+		result, err := Split(v.Amount, "USD", v.N, v.Strategy)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if !reflect.DeepEqual(result, v.Output) {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	m, _ := NewMoney(math.MinInt64, "USD")
+	parts, err := m.Split(7, LargestRemainder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sum int64
+	for _, v := range parts {
+		if v.Alpha() != "USD" {
+			t.Error(v.Alpha())
+		}
+		sum += v.Amount()
+	}
+	if sum != math.MinInt64 {
+		t.Error("Expected:", int64(math.MinInt64), "Got:", sum)
+	}
+
+	m, _ = NewMoney(math.MaxInt64, "USD")
+	parts, err = m.Allocate(LargestRemainder, 1, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum = 0
+	for _, v := range parts {
+		sum += v.Amount()
+	}
+	if sum != math.MaxInt64 {
+		t.Error("Expected:", int64(math.MaxInt64), "Got:", sum)
+	}
+
+	if _, err := m.Split(0, LargestRemainder); err != ErrorInvalidAllocation {
+		t.Error("Expected:", ErrorInvalidAllocation, "Got:", err)
+	}
+}
+
+func TestAllocateSumsToAmount(t *testing.T) {
+	ratios := []int{13, 7, 29, 1, 50}
+	for _, strategy := range []remainder{LargestRemainder, FirstRemainder, LastRemainder} {
+		for i := -9999; i <= 9999; i += 7 {
+			result, err := Allocate(i, "USD", strategy, ratios...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sum := 0
+			for _, v := range result {
+				sum += v
+			}
+			if sum != i {
+				t.Error("Expected:", i, "Got:", sum, "Using:", strategy)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Command iso4217gen regenerates currency_list.go from the ISO 4217 list one XML publication.
+//
+// The unit, numeric code and fraction of each currency come from ISO, while the symbol, decimal,
+// grouping and delimiter dough maintains are kept. The additions, removals and fraction changes
+// are printed so they can be reviewed before committing.
+//
+//	go run ./cmd/iso4217gen -in list-one.xml -out currency_list.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/fluidpay/dough"
+)
+
+func main() {
+	in := flag.String("in", "list-one.xml", "ISO 4217 list one XML file")
+	out := flag.String("out", "currency_list.go", "Go file to write CurrencyList to")
+	check := flag.Bool("check", false, "only print the differences and exit 1 if there are any")
+	flag.Parse()
+
+	table, err := dough.LoadISO4217File(*in)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "iso4217gen:", err)
+		os.Exit(1)
+	}
+	merged, diff := dough.MergeISO4217(dough.CurrencyList, table)
+	if report := diff.String(); report != "" {
+		fmt.Println(report)
+	}
+	if *check {
+		if !diff.IsEmpty() {
+			os.Exit(1)
+		}
+		return
+	}
+
+	src, err := generate(merged)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "iso4217gen:", err)
+		os.Exit(1)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, "iso4217gen:", err)
+		os.Exit(1)
+	}
+}
+
+// generate : returns the gofmt'd source of currency_list.go for a currency list
+func generate(list map[string]dough.Currency) ([]byte, error) {
+	alphas := make([]string, 0, len(list))
+	for alpha := range list {
+		alphas = append(alphas, alpha)
+	}
+	sort.Strings(alphas)
+
+	var buf bytes.Buffer
+	buf.WriteString("package dough\n\n")
+	buf.WriteString("// CurrencyList - complete list of supported currencies\n")
+	buf.WriteString("var CurrencyList = map[string]Currency{\n")
+	for _, alpha := range alphas {
+		ISO := list[alpha]
+		fmt.Fprintf(&buf, "%q: {\n", alpha)
+		fmt.Fprintf(&buf, "Unit: %q,\n", ISO.Unit)
+		fmt.Fprintf(&buf, "Alpha: %q,\n", ISO.Alpha)
+		fmt.Fprintf(&buf, "Numeric: %q,\n", ISO.Numeric)
+		fmt.Fprintf(&buf, "Symbol: %s,\n", escape(ISO.Symbol))
+		if ISO.Fraction == dough.FractionNA {
+			buf.WriteString("Fraction: FractionNA,\n")
+		} else {
+			fmt.Fprintf(&buf, "Fraction: %d,\n", ISO.Fraction)
+		}
+		fmt.Fprintf(&buf, "Decimal: %q,\n", ISO.Decimal)
+		fmt.Fprintf(&buf, "Grouping: %d,\n", ISO.Grouping)
+		if ISO.SecondaryGrouping != 0 {
+			fmt.Fprintf(&buf, "SecondaryGrouping: %d,\n", ISO.SecondaryGrouping)
+		}
+		fmt.Fprintf(&buf, "Delimiter: %q,\n", ISO.Delimiter)
+		fmt.Fprintf(&buf, "SymbolPositionFront: %t,\n", ISO.SymbolPositionFront)
+		if ISO.SymbolSpace {
+			fmt.Fprintf(&buf, "SymbolSpace: %t,\n", ISO.SymbolSpace)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// escape : returns a symbol as a Go string literal of lower case \u escapes with spaces left as they are,
+// the way currency_list.go writes them
+func escape(symbol string) string {
+	var buf bytes.Buffer
+	buf.WriteByte('"')
+	for _, r := range symbol {
+		if r == ' ' {
+			buf.WriteRune(r)
+			continue
+		}
+		if r > 0xffff {
+			fmt.Fprintf(&buf, "\\U%08x", r)
+			continue
+		}
+		fmt.Fprintf(&buf, "\\u%04x", r)
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/fluidpay/dough"
+)
+
+func TestGenerate(t *testing.T) {
+	src, err := generate(dough.CurrencyList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(src)
+	if !strings.HasPrefix(out, "package dough\n\n// CurrencyList - complete list of supported currencies\nvar CurrencyList = map[string]Currency{\n\t\"AED\": {\n") {
+		t.Error("Got:", out[:200])
+	}
+
+	inr := "\t\"INR\": {\n\t\tUnit:                \"Indian Rupee\",\n\t\tAlpha:               \"INR\",\n\t\tNumeric:             \"356\",\n\t\tSymbol:              \"\\u20b9\",\n\t\tFraction:            2,\n\t\tDecimal:             \".\",\n\t\tGrouping:            3,\n\t\tSecondaryGrouping:   2,\n\t\tDelimiter:           \",\",\n\t\tSymbolPositionFront: true,\n\t},\n"
+	if !strings.Contains(out, inr) {
+		t.Error("Expected:", inr)
+	}
+	if !strings.Contains(out, "\"XAU\",\n\t\tNumeric:             \"959\",\n\t\tSymbol:              \"\\u0058\\u0041\\u0055\",\n\t\tFraction:            FractionNA,\n") {
+		t.Error("Expected:", "XAU with Fraction FractionNA")
+	}
+	if strings.Count(out, "Alpha:") != len(dough.CurrencyList) {
+		t.Error("Expected:", len(dough.CurrencyList), "Got:", strings.Count(out, "Alpha:"))
+	}
+}
+
+func TestEscape(t *testing.T) {
+	for _, symbol := range []string{"$", "₹", "إ.د", "Bs. S", "₿", ""} {
+		result, err := strconv.Unquote(escape(symbol))
+		if err != nil || result != symbol {
+			t.Error("Expected:", symbol, "Got:", result, err)
+		}
+	}
+	if escape("$") != "\"\\u0024\"" {
+		t.Error("Expected:", "\"\\u0024\"", "Got:", escape("$"))
+	}
+	if escape("Bs. S") != "\"\\u0042\\u0073\\u002e \\u0053\"" {
+		t.Error("Expected:", "\"\\u0042\\u0073\\u002e \\u0053\"", "Got:", escape("Bs. S"))
+	}
+}
+
+func TestGenerateCurrencyList(t *testing.T) {
+	src, err := generate(dough.CurrencyList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := ioutil.ReadFile("../../currency_list.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(src, file) {
+		t.Error("Expected:", "generating from CurrencyList to reproduce currency_list.go")
+	}
+}
@@ -0,0 +1,98 @@
+package dough
+
+import "strings"
+
+// compactUnit - a power of ten and the suffix written for amounts of that size
+type compactUnit struct {
+	exponent int
+	suffix   string
+}
+
+// compactWestern - thousand, million, billion and trillion
+var compactWestern = []compactUnit{{3, "K"}, {6, "M"}, {9, "B"}, {12, "T"}}
+
+// compactIndian - thousand, lakh and crore for currencies grouped 3 then 2
+var compactIndian = []compactUnit{{3, "K"}, {5, "L"}, {7, "Cr"}}
+
+// compactUnits : returns the compact units matching how the currency groups its digits
+func compactUnits(ISO Currency) []compactUnit {
+	if ISO.Grouping == 3 && ISO.SecondaryGrouping == 2 {
+		return compactIndian
+	}
+	return compactWestern
+}
+
+// DisplayCompact : returns an abbreviated amount such as "$1.2K" for dashboards... "num" being the amount, "alpha" being the ISO three digit alphabetic code, "digits" being the significant digits to keep.
+func DisplayCompact(num int, alpha string, digits int, mode round) (string, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return "", err
+	}
+	return FormatCompact(num, ISO, digits, mode), nil
+}
+
+// FormatCompact : returns an abbreviated amount scaled into K/M/B/T, or K/L/Cr for lakh/crore currencies,
+// keeping digits significant digits rounded based upon the round mode
+func FormatCompact(num int, ISO Currency, digits int, mode round) string {
+	if digits < 1 {
+		digits = 1
+	}
+	units := compactUnits(ISO)
+	value := NewDecimal(int64(num), ISO.MinorDigits())
+
+	// Pick the largest unit the amount reaches, moving up a unit when rounding carries into it
+	unit := -1
+	for key := len(units) - 1; key >= 0; key-- {
+		if value.Abs().Cmp(NewDecimal(1, -units[key].exponent)) >= 0 {
+			unit = key
+			break
+		}
+	}
+	var rounded Decimal
+	for {
+		scaled, maxScale := value, ISO.MinorDigits()
+		if unit >= 0 {
+			scaled, maxScale = value.Shift(-units[unit].exponent), digits
+		}
+		// Significant digits count from the leading digit, so 0.05 keeps its hundredths
+		scale := digits - (len(scaled.Abs().coef.String()) - scaled.scale)
+		if scale < 0 {
+			scale = 0
+		}
+		if scale > maxScale {
+			scale = maxScale
+		}
+		rounded = scaled.Round(scale, mode)
+		if unit+1 >= len(units) {
+			break
+		}
+		next := units[unit+1].exponent
+		if unit >= 0 {
+			next -= units[unit].exponent
+		}
+		if rounded.Abs().Cmp(NewDecimal(1, -next)) < 0 {
+			break
+		}
+		unit++
+	}
+
+	str := rounded.Abs().String()
+	if strings.Contains(str, ".") {
+		str = strings.TrimRight(strings.TrimRight(str, "0"), ".")
+	}
+	str = strings.Replace(str, ".", ISO.Decimal, 1)
+	if unit >= 0 {
+		str += units[unit].suffix
+	}
+	if rounded.Sign() < 0 {
+		str = "-" + str
+	}
+	space := ""
+	if ISO.SymbolSpace {
+		space = " "
+	}
+	if ISO.SymbolPositionFront != true {
+		return str + space + ISO.Symbol
+	}
+	return ISO.Symbol + space + str
+}
@@ -0,0 +1,62 @@
+package dough
+
+import "testing"
+
+var TestDisplayCompactData = []struct {
+	Num    int64
+	Alpha  string
+	Digits int
+	Mode   round
+	Output string
+}{
+	{0, "USA", 2, Round, ErrorInvalidISO.Error()},
+	{0, "USD", 2, Round, "$0"},
+	{5, "USD", 2, Round, "$0.05"},
+	{150, "USD", 2, Round, "$1.5"},
+	{1234, "USD", 2, Round, "$12"},
+	{123456, "USD", 2, Round, "$1.2K"},
+	{123456, "USD", 3, Round, "$1.23K"},
+	{123456, "USD", 0, Round, "$1K"},
+	{340000000, "USD", 2, Round, "$3.4M"},
+	{1200000000000, "EUR", 2, Round, "€12B"},
+	{123456, "EUR", 2, Round, "€1.2K"},
+	{123456, "AED", 2, Round, "1.2Kإ.د"},
+	{123456, "JPY", 2, Round, "¥123K"},
+	{-123456, "USD", 2, Round, "$-1.2K"},
+
+	// Rounding modes
+	{125000, "USD", 2, Bankers, "$1.2K"},
+	{125000, "USD", 2, Round, "$1.3K"},
+	{129999, "USD", 2, Floor, "$1.2K"},
+	{120001, "USD", 2, Ceil, "$1.3K"},
+	{-123456, "USD", 2, Floor, "$-1.3K"},
+
+	// Rounding that carries into the next unit
+	{99999999, "USD", 2, Round, "$1M"},
+	{99949999, "USD", 3, Round, "$999K"},
+	{99950, "USD", 3, Round, "$1K"},
+	{999999999999999999, "USD", 2, Round, "$10000T"},
+
+	// Lakh and crore
+	{12345600, "INR", 2, Round, "₹1.2L"},
+	{1234567800, "INR", 3, Round, "₹1.23Cr"},
+	{999999999, "INR", 2, Round, "₹1Cr"},
+	{100000000000000, "INR", 2, Round, "₹100000Cr"},
+}
+
+func TestDisplayCompact(t *testing.T) {
+	for _, v := range TestDisplayCompactData {
+		// Amounts past the range of int are only tested where int is 64 bits
+		if int64(int(v.Num)) != v.Num {
+			continue
+		}
+		result, err := DisplayCompact(int(v.Num), v.Alpha, v.Digits, v.Mode)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
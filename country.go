@@ -0,0 +1,71 @@
+package dough
+
+import (
+	"sort"
+	"strings"
+)
+
+// countryIndex - alpha codes keyed by ISO 3166 alpha-2 country code, the default from countryDefaults
+// or else the currency a country issues first
+var countryIndex = func() map[string][]string {
+	index := map[string][]string{}
+	for alpha, countries := range currencyCountryList {
+		for _, country := range countries {
+			index[country] = append(index[country], alpha)
+		}
+	}
+	for country, alphas := range index {
+		issuer := func(alpha string) bool { return currencyCountryList[alpha][0] == country }
+		sort.Slice(alphas, func(i, j int) bool {
+			if def := countryDefaults[country]; (alphas[i] == def) != (alphas[j] == def) {
+				return alphas[i] == def
+			}
+			if issuer(alphas[i]) != issuer(alphas[j]) {
+				return issuer(alphas[i])
+			}
+			return alphas[i] < alphas[j]
+		})
+	}
+	return index
+}()
+
+// CurrenciesForCountry : returns the currencies used in an ISO 3166 alpha-2 country, its own currency first
+func CurrenciesForCountry(country string) ([]Currency, error) {
+	alphas, ok := countryIndex[strings.ToUpper(country)]
+	if !ok {
+		return nil, ErrorInvalidCountry
+	}
+	return ListCurrencies(alphas)
+}
+
+// DefaultCurrencyForCountry : returns the currency a checkout in an ISO 3166 alpha-2 country should default to
+func DefaultCurrencyForCountry(country string) (Currency, error) {
+	currencies, err := CurrenciesForCountry(country)
+	if err != nil {
+		return Currency{}, err
+	}
+	return currencies[0], nil
+}
+
+// CountriesForCurrency : returns the ISO 3166 alpha-2 codes of the countries using a currency, or the country
+// of a fund code
+func CountriesForCurrency(alpha string) ([]string, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{}, currencyCountries(ISO.Alpha)...), nil
+}
+
+// IsCurrencyOfCountry : returns whether a currency is used in an ISO 3166 alpha-2 country, or is a fund of it
+func IsCurrencyOfCountry(alpha string, country string) bool {
+	return containsString(currencyCountries(strings.ToUpper(alpha)), strings.ToUpper(country))
+}
+
+// currencyCountries : returns the countries of an upper case alpha code from currencyCountryList or fundCountries
+func currencyCountries(alpha string) []string {
+	if countries, ok := fundCountries[alpha]; ok {
+		return countries
+	}
+	return currencyCountryList[alpha]
+}
@@ -0,0 +1,192 @@
+package dough
+
+// currencyCountryList - ISO 3166 alpha-2 codes of the countries and territories using each currency
+//
+// The issuing country is listed first where there is one, which CurrenciesForCountry uses to put a
+// country's own currency ahead of foreign currencies it also uses. HRK is left out as Croatia has used
+// EUR since 2023. The list is read once to build countryIndex and is not changed after, so callers
+// get copies through CountriesForCurrency.
+var currencyCountryList = map[string][]string{
+	"AED": {"AE"},
+	"AFN": {"AF"},
+	"ALL": {"AL"},
+	"AMD": {"AM"},
+	"ANG": {"CW", "SX"},
+	"AOA": {"AO"},
+	"ARS": {"AR"},
+	"AUD": {"AU", "CX", "CC", "HM", "KI", "NR", "NF", "TV"},
+	"AWG": {"AW"},
+	"AZN": {"AZ"},
+	"BAM": {"BA"},
+	"BBD": {"BB"},
+	"BDT": {"BD"},
+	"BGN": {"BG"},
+	"BHD": {"BH"},
+	"BIF": {"BI"},
+	"BMD": {"BM"},
+	"BND": {"BN"},
+	"BOB": {"BO"},
+	"BRL": {"BR"},
+	"BSD": {"BS"},
+	"BTN": {"BT"},
+	"BWP": {"BW"},
+	"BYN": {"BY"},
+	"BZD": {"BZ"},
+	"CAD": {"CA"},
+	"CDF": {"CD"},
+	"CHF": {"CH", "LI"},
+	"CLP": {"CL"},
+	"CNY": {"CN"},
+	"COP": {"CO"},
+	"CRC": {"CR"},
+	"CUC": {"CU"},
+	"CUP": {"CU"},
+	"CVE": {"CV"},
+	"CZK": {"CZ"},
+	"DJF": {"DJ"},
+	"DKK": {"DK", "FO", "GL"},
+	"DOP": {"DO"},
+	"DZD": {"DZ"},
+	"EGP": {"EG"},
+	"ERN": {"ER"},
+	"ETB": {"ET"},
+	"EUR": {"AD", "AT", "AX", "BE", "BL", "CY", "DE", "EE", "ES", "FI", "FR", "GF", "GP", "GR", "HR", "IE", "IT", "LT", "LU", "LV", "MC", "ME", "MF", "MQ", "MT", "NL", "PM", "PT", "RE", "SI", "SK", "SM", "TF", "VA", "YT"},
+	"FJD": {"FJ"},
+	"FKP": {"FK"},
+	"GBP": {"GB", "GG", "IM", "JE"},
+	"GEL": {"GE"},
+	"GHS": {"GH"},
+	"GIP": {"GI"},
+	"GMD": {"GM"},
+	"GNF": {"GN"},
+	"GTQ": {"GT"},
+	"GYD": {"GY"},
+	"HKD": {"HK"},
+	"HNL": {"HN"},
+	"HTG": {"HT"},
+	"HUF": {"HU"},
+	"IDR": {"ID"},
+	"ILS": {"IL", "PS"},
+	"INR": {"IN", "BT"},
+	"IQD": {"IQ"},
+	"IRR": {"IR"},
+	"ISK": {"IS"},
+	"JMD": {"JM"},
+	"JOD": {"JO", "PS"},
+	"JPY": {"JP"},
+	"KES": {"KE"},
+	"KGS": {"KG"},
+	"KHR": {"KH"},
+	"KMF": {"KM"},
+	"KPW": {"KP"},
+	"KRW": {"KR"},
+	"KWD": {"KW"},
+	"KYD": {"KY"},
+	"KZT": {"KZ"},
+	"LAK": {"LA"},
+	"LBP": {"LB"},
+	"LKR": {"LK"},
+	"LRD": {"LR"},
+	"LSL": {"LS"},
+	"LYD": {"LY"},
+	"MAD": {"MA", "EH"},
+	"MDL": {"MD"},
+	"MGA": {"MG"},
+	"MKD": {"MK"},
+	"MMK": {"MM"},
+	"MNT": {"MN"},
+	"MOP": {"MO"},
+	"MRU": {"MR"},
+	"MUR": {"MU"},
+	"MVR": {"MV"},
+	"MWK": {"MW"},
+	"MXN": {"MX"},
+	"MYR": {"MY"},
+	"MZN": {"MZ"},
+	"NAD": {"NA"},
+	"NGN": {"NG"},
+	"NIO": {"NI"},
+	"NOK": {"NO", "BV", "SJ"},
+	"NPR": {"NP"},
+	"NZD": {"NZ", "CK", "NU", "PN", "TK"},
+	"OMR": {"OM"},
+	"PAB": {"PA"},
+	"PEN": {"PE"},
+	"PGK": {"PG"},
+	"PHP": {"PH"},
+	"PKR": {"PK"},
+	"PLN": {"PL"},
+	"PYG": {"PY"},
+	"QAR": {"QA"},
+	"RON": {"RO"},
+	"RSD": {"RS"},
+	"RUB": {"RU"},
+	"RWF": {"RW"},
+	"SAR": {"SA"},
+	"SBD": {"SB"},
+	"SCR": {"SC"},
+	"SDG": {"SD"},
+	"SEK": {"SE"},
+	"SGD": {"SG"},
+	"SHP": {"SH"},
+	"SLL": {"SL"},
+	"SOS": {"SO"},
+	"SRD": {"SR"},
+	"SSP": {"SS"},
+	"STN": {"ST"},
+	"SVC": {"SV"},
+	"SYP": {"SY"},
+	"SZL": {"SZ"},
+	"THB": {"TH"},
+	"TJS": {"TJ"},
+	"TMT": {"TM"},
+	"TND": {"TN"},
+	"TOP": {"TO"},
+	"TRY": {"TR"},
+	"TTD": {"TT"},
+	"TWD": {"TW"},
+	"TZS": {"TZ"},
+	"UAH": {"UA"},
+	"UGX": {"UG"},
+	"USD": {"US", "AS", "BQ", "EC", "FM", "GU", "HT", "IO", "MH", "MP", "PA", "PR", "PW", "SV", "TC", "TL", "UM", "VG", "VI"},
+	"UYU": {"UY"},
+	"UZS": {"UZ"},
+	"VES": {"VE"},
+	"VND": {"VN"},
+	"VUV": {"VU"},
+	"WST": {"WS"},
+	"XAF": {"CF", "CG", "CM", "GA", "GQ", "TD"},
+	"XCD": {"AG", "AI", "DM", "GD", "KN", "LC", "MS", "VC"},
+	"XOF": {"BF", "BJ", "CI", "GW", "ML", "NE", "SN", "TG"},
+	"XPF": {"NC", "PF", "WF"},
+	"YER": {"YE"},
+	"ZAR": {"ZA", "LS", "NA"},
+	"ZMW": {"ZM"},
+	"ZWL": {"ZW"},
+}
+
+// countryDefaults - the currency a checkout defaults to in countries where it is not the one the
+// issuer first rule picks, keyed by ISO 3166 alpha-2 code
+//
+// Cuba prices in CUP since CUC was withdrawn from circulation in 2021, and El Salvador has used USD in
+// place of SVC since 2001.
+var countryDefaults = map[string]string{
+	"CU": "CUP",
+	"SV": "USD",
+}
+
+// fundCountries - ISO 3166 alpha-2 codes of the country each ISO 4217 fund code is listed under
+//
+// Funds are units of account rather than currencies a checkout is paid in, so they are kept out of
+// CurrenciesForCountry and DefaultCurrencyForCountry.
+var fundCountries = map[string][]string{
+	"BOV": {"BO"},
+	"CHE": {"CH"},
+	"CHW": {"CH"},
+	"CLF": {"CL"},
+	"COU": {"CO"},
+	"MXV": {"MX"},
+	"USN": {"US"},
+	"UYI": {"UY"},
+	"UYW": {"UY"},
+}
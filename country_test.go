@@ -0,0 +1,121 @@
+package dough
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var TestCurrenciesForCountryData = []struct {
+	Country string
+	Output  interface{}
+}{
+	{"ZZ", ErrorInvalidCountry.Error()},
+	{"", ErrorInvalidCountry.Error()},
+	{"US", []string{"USD"}},
+	{"de", []string{"EUR"}},
+	{"HR", []string{"EUR"}},
+	{"PA", []string{"PAB", "USD"}},
+	{"BT", []string{"BTN", "INR"}},
+	{"LS", []string{"LSL", "ZAR"}},
+	{"CU", []string{"CUP", "CUC"}},
+	{"SV", []string{"USD", "SVC"}},
+	{"ps", []string{"ILS", "JOD"}},
+	{"EC", []string{"USD"}},
+	{"UY", []string{"UYU"}},
+}
+
+func TestCurrenciesForCountry(t *testing.T) {
+	for _, v := range TestCurrenciesForCountryData {
+		result, err := CurrenciesForCountry(v.Country)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error("Expected:", v.Output, "Got:", err)
+			}
+		} else if !reflect.DeepEqual(alphaCodes(result), v.Output) {
+			t.Error("Expected:", v.Output, "Got:", alphaCodes(result))
+		}
+	}
+}
+
+func TestDefaultCurrencyForCountry(t *testing.T) {
+	ISO, err := DefaultCurrencyForCountry("na")
+	if err != nil || ISO.Alpha != "NAD" {
+		t.Error("Expected:", "NAD", "Got:", ISO.Alpha, err)
+	}
+	if _, err := DefaultCurrencyForCountry("ZZ"); err != ErrorInvalidCountry {
+		t.Error("Expected:", ErrorInvalidCountry, "Got:", err)
+	}
+}
+
+func TestCountriesForCurrency(t *testing.T) {
+	result, err := CountriesForCurrency("chf")
+	if err != nil || !reflect.DeepEqual(result, []string{"CH", "LI"}) {
+		t.Error("Expected:", []string{"CH", "LI"}, "Got:", result, err)
+	}
+	result, err = CountriesForCurrency("HRK")
+	if err != nil || len(result) != 0 {
+		t.Error("Expected:", "no countries", "Got:", result, err)
+	}
+	result, err = CountriesForCurrency("clf")
+	if err != nil || !reflect.DeepEqual(result, []string{"CL"}) {
+		t.Error("Expected:", []string{"CL"}, "Got:", result, err)
+	}
+	if _, err := CountriesForCurrency("USA"); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+
+	// Changing the result must not change the data
+	result, _ = CountriesForCurrency("CHF")
+	result[0] = "ZZ"
+	if currencyCountryList["CHF"][0] != "CH" {
+		t.Error("Expected:", "CH", "Got:", currencyCountryList["CHF"][0])
+	}
+}
+
+func TestIsCurrencyOfCountry(t *testing.T) {
+	if !IsCurrencyOfCountry("usd", "pa") || !IsCurrencyOfCountry("EUR", "FR") || IsCurrencyOfCountry("EUR", "US") || IsCurrencyOfCountry("HRK", "HR") {
+		t.Error("Expected:", "USD in PA, EUR in FR, not EUR in US or HRK in HR")
+	}
+	if !IsCurrencyOfCountry("uyi", "UY") || IsCurrencyOfCountry("UYI", "AR") {
+		t.Error("Expected:", "UYI in UY only")
+	}
+}
+
+func TestFundCountries(t *testing.T) {
+	for alpha, countries := range fundCountries {
+		if _, err := GetISOFromAlpha(alpha); err != nil {
+			t.Error("Expected:", alpha, "in CurrencyList", "Got:", err)
+		}
+		if _, ok := currencyCountryList[alpha]; ok {
+			t.Error("Expected:", alpha, "only in fundCountries")
+		}
+		for _, country := range countries {
+			if _, err := CurrenciesForCountry(country); err != nil {
+				t.Error("Expected:", country, "in currencyCountryList", "Got:", err)
+			}
+		}
+	}
+	ISO, err := DefaultCurrencyForCountry("CL")
+	if err != nil || ISO.Alpha != "CLP" {
+		t.Error("Expected:", "CLP", "Got:", ISO.Alpha, err)
+	}
+}
+
+func TestCurrencyCountries(t *testing.T) {
+	for alpha, countries := range currencyCountryList {
+		if _, err := GetISOFromAlpha(alpha); err != nil {
+			t.Error("Expected:", alpha, "in CurrencyList", "Got:", err)
+		}
+		for _, country := range countries {
+			if len(country) != 2 || strings.ToUpper(country) != country {
+				t.Error("Expected:", "an ISO 3166 alpha-2 code", "Got:", country)
+			}
+		}
+	}
+	for country, alpha := range countryDefaults {
+		if ISO, err := DefaultCurrencyForCountry(country); err != nil || ISO.Alpha != alpha {
+			t.Error("Expected:", alpha, "for", country, "Got:", ISO.Alpha, err)
+		}
+	}
+}
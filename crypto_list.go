@@ -0,0 +1,66 @@
+package dough
+
+// CryptoCurrencyList - cryptocurrencies and stablecoins, which have no ISO numeric code
+//
+// These are not in DefaultRegistry until registered with DefaultRegistry.RegisterList(CryptoCurrencyList).
+// Fractions go up to 18 digits, so amounts above about 9.2 ETH need the BigInt variants such as
+// StringToBigInt and DisplayFullBigInt.
+var CryptoCurrencyList = map[string]Currency{
+	"BTC": {
+		Unit:                "Bitcoin",
+		Alpha:               "BTC",
+		Numeric:             "",
+		Symbol:              "₿",
+		Fraction:            8,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: true,
+	},
+	"ETH": {
+		Unit:                "Ether",
+		Alpha:               "ETH",
+		Numeric:             "",
+		Symbol:              "Ξ",
+		Fraction:            18,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: true,
+	},
+	"LTC": {
+		Unit:                "Litecoin",
+		Alpha:               "LTC",
+		Numeric:             "",
+		Symbol:              "Ł",
+		Fraction:            8,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: true,
+	},
+	"USDC": {
+		Unit:                "USD Coin",
+		Alpha:               "USDC",
+		Numeric:             "",
+		Symbol:              "USDC",
+		Fraction:            6,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"USDT": {
+		Unit:                "Tether",
+		Alpha:               "USDT",
+		Numeric:             "",
+		Symbol:              "USDT",
+		Fraction:            6,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+}
@@ -0,0 +1,82 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+// registerCrypto adds CryptoCurrencyList to DefaultRegistry and returns a function removing it again
+func registerCrypto(t *testing.T) func() {
+	if err := DefaultRegistry.RegisterList(CryptoCurrencyList); err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		for alpha := range CryptoCurrencyList {
+			DefaultRegistry.Unregister(alpha)
+		}
+	}
+}
+
+var TestCryptoDisplayData = []struct {
+	Num    string
+	Alpha  string
+	Output string
+}{
+	{"1", "BTC", "₿0.00000001"},
+	{"2100000000000000", "BTC", "₿21,000,000.00000000"},
+	{"1", "ETH", "Ξ0.000000000000000001"},
+	{"123456789012345678901234567", "ETH", "Ξ123,456,789.012345678901234567"},
+	{"-1500000000000000000", "ETH", "Ξ-1.500000000000000000"},
+	{"1234567890", "USDC", "1,234.567890 USDC"},
+	{"1", "USDT", "0.000001 USDT"},
+}
+
+func TestCryptoDisplay(t *testing.T) {
+	defer registerCrypto(t)()
+	for _, v := range TestCryptoDisplayData {
+		num, _ := new(big.Int).SetString(v.Num, 10)
+		result, err := DisplayFullBigInt(num, v.Alpha)
+		if err != nil || result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result, err)
+		}
+		parsed, err := StringToBigInt(result, v.Alpha)
+		if err != nil || parsed.Cmp(num) != 0 {
+			t.Error("Expected:", v.Num, "Got:", parsed, err)
+		}
+		parsed, err = StringToBigIntStrict(result, v.Alpha)
+		if err != nil || parsed.Cmp(num) != 0 {
+			t.Error("Expected:", v.Num, "Got:", parsed, err)
+		}
+	}
+}
+
+func TestCryptoRegistration(t *testing.T) {
+	if _, err := GetISOFromAlpha("BTC"); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+
+	r := NewRegistry(CurrencyList)
+	if err := r.RegisterList(CryptoCurrencyList); err != nil {
+		t.Error(err)
+	}
+	result, err := r.DisplayFull(150000000, "btc")
+	if err != nil || result != "₿1.50000000" {
+		t.Error("Expected:", "₿1.50000000", "Got:", result, err)
+	}
+
+	defer registerCrypto(t)()
+	m, err := ParseMoney("0.5 ETH")
+	if err != nil || m.Alpha() != "ETH" || m.Amount() != 500000000000000000 {
+		t.Error("Expected:", "500000000000000000 ETH", "Got:", m.Amount(), m.Alpha(), err)
+	}
+	m, err = ParseMoney("1,234.567890 USDC")
+	if err != nil || m.Alpha() != "USDC" || m.Amount() != 1234567890 {
+		t.Error("Expected:", "1234567890 USDC", "Got:", m.Amount(), m.Alpha(), err)
+	}
+	if result := FloatToInt(0.00000001, 8); result != 1 {
+		t.Error("Expected:", 1, "Got:", result)
+	}
+	if _, err := StringToInt64("Ξ10.000000000000000000", "ETH"); err != ErrorAmountOverflow {
+		t.Error("Expected:", ErrorAmountOverflow, "Got:", err)
+	}
+}
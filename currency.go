@@ -1,24 +1,86 @@
 package dough
 
 import (
-	"regexp"
+	"math"
+	"math/big"
 	"strconv"
 	"strings"
 )
 
-// StringToInt : returns a int from a string value
+// CurrencyList is regenerated from the ISO 4217 list one XML saved as list-one.xml, keeping the
+// formatting metadata of existing entries and printing what changed.
+//go:generate go run ./cmd/iso4217gen -in list-one.xml -out currency_list.go
+
+// Currency - struct containing currency variables
+//
+// Grouping is the size of the digit group nearest the decimal and SecondaryGrouping the size of
+// every group after it, zero meaning the same as Grouping. INR uses 3 and 2 for "12,34,567.00".
+// SymbolSpace puts a space between the symbol and the amount, as for symbols written as letters.
+// Fraction is FractionNA for codes such as XAU and XDR where ISO defines no minor unit.
+type Currency struct {
+	Unit                string
+	Alpha               string
+	Numeric             string
+	Symbol              string
+	Fraction            int
+	Decimal             string
+	Grouping            int
+	SecondaryGrouping   int
+	Delimiter           string
+	SymbolPositionFront bool
+	SymbolSpace         bool
+}
+
+// FractionNA - the Fraction of a currency with no minor unit defined, published by ISO as "N.A."
+//
+// Unlike Fraction 0, where a decimal is leniently dropped, amounts are always whole units and
+// parsing a string with a decimal returns ErrorNoMinorUnit.
+const FractionNA = -1
+
+// MinorDigits : returns the digits written after the decimal, which is 0 for FractionNA
+func (c Currency) MinorDigits() int {
+	return wholeScale(c.Fraction)
+}
+
+// StringToInt : returns a int from a string value, leniently dropping anything that is not a digit, minus or decimal
 func StringToInt(num string, alpha string, options ...bool) (int, error) {
 	ISO, err := GetISOFromAlpha(alpha)
 	if err != nil {
 		return 0, err
 	}
+	amount, err := parseAmount(num, ISO, options...)
+	if err != nil {
+		return 0, err
+	}
+	if !fitsInt(amount) {
+		return 0, ErrorAmountOverflow
+	}
+	return int(amount.Int64()), nil
+}
+
+// parseAmount : returns the minor unit amount of a string value based upon the ISO
+func parseAmount(num string, ISO Currency, options ...bool) (*big.Int, error) {
+	// Pull off negative styles the cleaning below would otherwise discard before the symbol,
+	// which may contain the decimal or end in "CR", is dropped
+	num, isNegative := trimNegative(num, ISO)
+	if ISO.Symbol != "" {
+		num = strings.Replace(num, ISO.Symbol, "", -1)
+	}
 
 	// Clean string
-	reg, _ := regexp.Compile("[^-" + ISO.Decimal + "0-9]+")
-	str := reg.ReplaceAllString(num, "")
+	str := cleanAmount(num, ISO.Decimal)
 	str = strings.Replace(str, ISO.Decimal, ".", -1) // Replace ISO specific decimal with float decimal .
-	if str == "" {
-		return 0, ErrorInvalidStringFormat
+	if strings.HasSuffix(str, "-") && !strings.HasPrefix(str, "-") {
+		str = "-" + strings.TrimSuffix(str, "-")
+	}
+	if isNegative {
+		if strings.HasPrefix(str, "-") {
+			return nil, ErrorInvalidStringFormat
+		}
+		str = "-" + str
+	}
+	if str == "" || str == "-" || strings.LastIndex(str, "-") > 0 {
+		return nil, ErrorInvalidStringFormat
 	}
 
 	// Validate ISO fraction matches
@@ -29,83 +91,123 @@ func StringToInt(num string, alpha string, options ...bool) (int, error) {
 	if len(options) >= 1 {
 		allowLoose = options[0]
 	}
-	if ISO.Fraction != 0 {
+	if ISO.Fraction == FractionNA {
+		if len(split) > 1 {
+			return nil, ErrorNoMinorUnit
+		}
+	} else if ISO.Fraction != 0 {
 		if !allowLoose && len(split) == 2 && len(split[1]) != ISO.Fraction {
-			return 0, ErrorInvalidISOFractionMatch
+			return nil, ErrorInvalidISOFractionMatch
 		}
 
-		// Convert to Float - to test if valid number
-		fl, err := strconv.ParseFloat(str, 64)
+		// Convert to Decimal - to test if valid number
+		dec, err := ParseDecimal(str)
 		if err != nil {
-			return 0, ErrorInvalidStringFormat
+			return nil, ErrorInvalidStringFormat
 		}
 
-		// Convert float to cents based upon iso fraction
-		return FloatToInt(fl, ISO.Fraction), nil
+		// Convert decimal to cents based upon iso fraction
+		return dec.MinorUnits(ISO.Fraction, Round), nil
 	}
 
 	s := strings.Replace(str, ".", "", -1)
-	i, err := strconv.Atoi(s)
-	if err != nil {
-		return 0, ErrorInvalidStringFormat
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, ErrorInvalidStringFormat
 	}
 	return i, nil
 }
 
+// cleanAmount : returns the string with everything except digits, minus signs and the decimal removed
+func cleanAmount(num string, decimal string) string {
+	var str strings.Builder
+	str.Grow(len(num))
+	for key := 0; key < len(num); key++ {
+		switch c := num[key]; {
+		case c >= '0' && c <= '9' || c == '-':
+			str.WriteByte(c)
+		case decimal != "" && strings.HasPrefix(num[key:], decimal):
+			str.WriteString(decimal)
+			key += len(decimal) - 1
+		}
+	}
+	return str.String()
+}
+
+// trimNegative : returns the string without parentheses or a CR suffix and whether either marked it negative
+func trimNegative(num string, ISO Currency) (string, bool) {
+	str := strings.TrimSpace(num)
+	if strings.HasPrefix(str, "(") && strings.HasSuffix(str, ")") {
+		return str[1 : len(str)-1], true
+	}
+	if hasCreditSuffix(str, ISO) {
+		return str[:len(str)-2], true
+	}
+	return num, false
+}
+
+// hasCreditSuffix : returns whether a trimmed string ends in "CR" that is not the end of the ISO alpha code
+// or symbol, so "1.00 CR" and "R1.00CR" are credits while "1.00 SCR" is not
+func hasCreditSuffix(str string, ISO Currency) bool {
+	if len(str) < 3 || !strings.EqualFold(str[len(str)-2:], "CR") {
+		return false
+	}
+	for _, symbol := range []string{ISO.Alpha, ISO.Symbol} {
+		if len(symbol) > 2 && len(str) >= len(symbol) && strings.EqualFold(str[len(str)-len(symbol):], symbol) {
+			return false
+		}
+	}
+	return true
+}
+
+// fitsInt : returns whether a big.Int can be stored in the platform int
+func fitsInt(num *big.Int) bool {
+	if !num.IsInt64() {
+		return false
+	}
+	return strconv.IntSize == 64 || (num.Int64() >= math.MinInt32 && num.Int64() <= math.MaxInt32)
+}
+
 // DisplayFull : returns a string with full currency formatting... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
 func DisplayFull(num int, alpha string) (string, error) {
+	return DisplayFullInt64(int64(num), alpha)
+}
+
+// DisplayFullNegative : returns a string with full currency formatting with negative amounts written in the given style... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
+func DisplayFullNegative(num int, alpha string, style negative) (string, error) {
 	ISO, err := GetISOFromAlpha(alpha)
 	if err != nil {
 		return "", err
 	}
-	return FormatCurrency(num, ISO), nil
+	opts := displayOptions(ISO, SymbolDefault, true)
+	opts.Negative = style
+	return Format(num, ISO.Alpha, opts)
 }
 
 // DisplayWithAlpha : returns a string with full currency formatting with the symbol replaced by the ISO three digit alphabetic code... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
 func DisplayWithAlpha(num int, alpha string) (string, error) {
-	ISO, err := GetISOFromAlpha(alpha)
-	if err != nil {
-		return "", err
-	}
-	currency := FormatCurrency(num, ISO)
-	return SwapSymbolWithAlpha(currency, ISO.Symbol, ISO.Alpha), nil
+	return DisplayWithAlphaInt64(int64(num), alpha)
 }
 
 // DisplayNoSymbol : returns a string with full currency formatting minus the ISO symbol... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
 func DisplayNoSymbol(num int, alpha string) (string, error) {
-	ISO, err := GetISOFromAlpha(alpha)
-	if err != nil {
-		return "", err
-	}
-	currency := FormatCurrency(num, ISO)
-	return removeSymbol(currency, ISO.Symbol), nil
+	return DisplayNoSymbolInt64(int64(num), alpha)
 }
 
 // DisplayWithDecimal : returns a string with all currency formatting removed except decimal places... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
 func DisplayWithDecimal(num int, alpha string) (string, error) {
-	ISO, err := GetISOFromAlpha(alpha)
-	if err != nil {
-		return "", err
-	}
-	currency := FormatCurrency(num, ISO)
-	currency = removeSymbol(currency, ISO.Symbol)
-	return removeDelimiter(currency, ISO.Delimiter), nil
+	return DisplayWithDecimalInt64(int64(num), alpha)
 }
 
+// topAlphas - the ISO three digit alphabetic codes of the top currencies based upon usage
+var topAlphas = []string{"USD", "EUR", "GBP", "INR", "CRC", "VND", "HUF", "ILS", "CNY", "KRW", "NGN", "PYG", "PHP", "PLN", "THB", "UAH", "JPY"}
+
 // TopCurrencies returns the list of top currencies based upon usage
 func TopCurrencies() ([]Currency, error) {
-	return ListCurrencies([]string{"USD", "EUR", "GBP", "INR", "CRC", "VND", "HUF", "ILS", "CNY", "KRW", "NGN", "PYG", "PHP", "PLN", "THB", "UAH", "JPY"})
+	return ListCurrencies(topAlphas)
 }
 
 // ListCurrencies : returns a list of currencies
 func ListCurrencies(list []string) ([]Currency, error) {
-	currencies := []Currency{}
-	for _, v := range list {
-		ISO, err := GetISOFromAlpha(v)
-		if err != nil {
-			return nil, err
-		}
-		currencies = append(currencies, ISO)
-	}
-	return currencies, nil
+	return DefaultRegistry.ListCurrencies(list)
 }
@@ -0,0 +1,50 @@
+package dough
+
+import "math/big"
+
+// StringToBigInt : returns a big.Int from a string value for amounts of any size
+func StringToBigInt(num string, alpha string, options ...bool) (*big.Int, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return nil, err
+	}
+	return parseAmount(num, ISO, options...)
+}
+
+// StringToBigIntStrict : returns a big.Int from a string value that must be written exactly as the ISO formats it
+func StringToBigIntStrict(num string, alpha string) (*big.Int, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return nil, err
+	}
+	return parseStrict(num, ISO)
+}
+
+// DisplayFullBigInt : returns a string with full currency formatting... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
+func DisplayFullBigInt(num *big.Int, alpha string) (string, error) {
+	return displayBigInt(num, alpha, SymbolDefault, true)
+}
+
+// DisplayWithAlphaBigInt : returns a string with full currency formatting with the symbol replaced by the ISO three digit alphabetic code... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
+func DisplayWithAlphaBigInt(num *big.Int, alpha string) (string, error) {
+	return displayBigInt(num, alpha, SymbolAlpha, true)
+}
+
+// DisplayNoSymbolBigInt : returns a string with full currency formatting minus the ISO symbol... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
+func DisplayNoSymbolBigInt(num *big.Int, alpha string) (string, error) {
+	return displayBigInt(num, alpha, SymbolNone, true)
+}
+
+// DisplayWithDecimalBigInt : returns a string with all currency formatting removed except decimal places... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
+func DisplayWithDecimalBigInt(num *big.Int, alpha string) (string, error) {
+	return displayBigInt(num, alpha, SymbolNone, false)
+}
+
+// displayBigInt : returns the Display formatting with the symbol mode and grouping applied
+func displayBigInt(num *big.Int, alpha string, symbol symbolMode, grouping bool) (string, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return "", err
+	}
+	return FormatBigInt(num, ISO.Alpha, displayOptions(ISO, symbol, grouping))
+}
@@ -0,0 +1,65 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+var TestStringToBigIntData = []struct {
+	Num    string
+	Alpha  string
+	Output string
+}{
+	{"$5", "USA", ErrorInvalidISO.Error()},
+	{"abcd", "USD", ErrorInvalidStringFormat.Error()},
+	{"$5.0", "USD", ErrorInvalidISOFractionMatch.Error()},
+	{"$5.52", "USD", "552"},
+	{"-$92,233,720,368,547,758.09", "USD", "-9223372036854775809"},
+	{"123,456,789,012,345,678,901,234", "IRR", "12345678901234567890123400"},
+	{"12,345,678,901,234,567,890,123", "VND", "12345678901234567890123"},
+}
+
+func TestStringToBigInt(t *testing.T) {
+	for _, v := range TestStringToBigIntData {
+		result, err := StringToBigInt(v.Num, v.Alpha)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result.String() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+var TestDisplayBigIntData = []struct {
+	Num     string
+	Alpha   string
+	Full    string
+	Alpha3  string
+	Symbol  string
+	Decimal string
+}{
+	{"0", "USA", ErrorInvalidISO.Error(), ErrorInvalidISO.Error(), ErrorInvalidISO.Error(), ErrorInvalidISO.Error()},
+	{"-9223372036854775809", "USD", "$-92,233,720,368,547,758.09", "USD -92,233,720,368,547,758.09", "-92,233,720,368,547,758.09", "-92233720368547758.09"},
+	{"12345678901234567890123", "VND", "₫12345678901234567890123", "VND 12345678901234567890123", "12345678901234567890123", "12345678901234567890123"},
+	{"1", "IRR", "﷼0.01", "IRR 0.01", "0.01", "0.01"},
+}
+
+func TestDisplayBigInt(t *testing.T) {
+	for _, v := range TestDisplayBigIntData {
+		num, _ := new(big.Int).SetString(v.Num, 10)
+		if result, err := DisplayFullBigInt(num, v.Alpha); err != nil && err.Error() != v.Full || err == nil && result != v.Full {
+			t.Error("Expected:", v.Full, "Got:", result, err)
+		}
+		if result, err := DisplayWithAlphaBigInt(num, v.Alpha); err != nil && err.Error() != v.Alpha3 || err == nil && result != v.Alpha3 {
+			t.Error("Expected:", v.Alpha3, "Got:", result, err)
+		}
+		if result, err := DisplayNoSymbolBigInt(num, v.Alpha); err != nil && err.Error() != v.Symbol || err == nil && result != v.Symbol {
+			t.Error("Expected:", v.Symbol, "Got:", result, err)
+		}
+		if result, err := DisplayWithDecimalBigInt(num, v.Alpha); err != nil && err.Error() != v.Decimal || err == nil && result != v.Decimal {
+			t.Error("Expected:", v.Decimal, "Got:", result, err)
+		}
+	}
+}
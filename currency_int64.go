@@ -0,0 +1,62 @@
+package dough
+
+// StringToInt64 : returns a int64 from a string value or ErrorAmountOverflow if it does not fit
+func StringToInt64(num string, alpha string, options ...bool) (int64, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return 0, err
+	}
+	amount, err := parseAmount(num, ISO, options...)
+	if err != nil {
+		return 0, err
+	}
+	if !amount.IsInt64() {
+		return 0, ErrorAmountOverflow
+	}
+	return amount.Int64(), nil
+}
+
+// StringToInt64Strict : returns a int64 from a string value that must be written exactly as the ISO formats it
+func StringToInt64Strict(num string, alpha string) (int64, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return 0, err
+	}
+	amount, err := parseStrict(num, ISO)
+	if err != nil {
+		return 0, err
+	}
+	if !amount.IsInt64() {
+		return 0, ErrorAmountOverflow
+	}
+	return amount.Int64(), nil
+}
+
+// DisplayFullInt64 : returns a string with full currency formatting... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
+func DisplayFullInt64(num int64, alpha string) (string, error) {
+	return displayInt64(num, alpha, SymbolDefault, true)
+}
+
+// DisplayWithAlphaInt64 : returns a string with full currency formatting with the symbol replaced by the ISO three digit alphabetic code... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
+func DisplayWithAlphaInt64(num int64, alpha string) (string, error) {
+	return displayInt64(num, alpha, SymbolAlpha, true)
+}
+
+// DisplayNoSymbolInt64 : returns a string with full currency formatting minus the ISO symbol... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
+func DisplayNoSymbolInt64(num int64, alpha string) (string, error) {
+	return displayInt64(num, alpha, SymbolNone, true)
+}
+
+// DisplayWithDecimalInt64 : returns a string with all currency formatting removed except decimal places... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
+func DisplayWithDecimalInt64(num int64, alpha string) (string, error) {
+	return displayInt64(num, alpha, SymbolNone, false)
+}
+
+// displayInt64 : returns the Display formatting with the symbol mode and grouping applied
+func displayInt64(num int64, alpha string, symbol symbolMode, grouping bool) (string, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return "", err
+	}
+	return FormatInt64(num, ISO.Alpha, displayOptions(ISO, symbol, grouping))
+}
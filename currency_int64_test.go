@@ -0,0 +1,67 @@
+package dough
+
+import (
+	"math"
+	"testing"
+)
+
+var TestStringToInt64Data = []struct {
+	Num    string
+	Alpha  string
+	Output interface{}
+}{
+	{"$5", "USA", ErrorInvalidISO.Error()},
+	{"abcd", "USD", ErrorInvalidStringFormat.Error()},
+	{"$5.0", "USD", ErrorInvalidISOFractionMatch.Error()},
+	{"$5.52", "USD", int64(552)},
+	{"$92,233,720,368,547,758.07", "USD", int64(math.MaxInt64)},
+	{"-$92,233,720,368,547,758.08", "USD", int64(math.MinInt64)},
+	{"$92,233,720,368,547,758.08", "USD", ErrorAmountOverflow.Error()},
+	{"-$92,233,720,368,547,758.09", "USD", ErrorAmountOverflow.Error()},
+	{"9,223,372,036,854,775,807", "IRR", ErrorAmountOverflow.Error()},
+	{"922,337,203,685,477,580", "VND", int64(922337203685477580)},
+}
+
+func TestStringToInt64(t *testing.T) {
+	for _, v := range TestStringToInt64Data {
+		result, err := StringToInt64(v.Num, v.Alpha)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+var TestDisplayInt64Data = []struct {
+	Num     int64
+	Alpha   string
+	Full    string
+	Alpha3  string
+	Symbol  string
+	Decimal string
+}{
+	{0, "USA", ErrorInvalidISO.Error(), ErrorInvalidISO.Error(), ErrorInvalidISO.Error(), ErrorInvalidISO.Error()},
+	{math.MaxInt64, "USD", "$92,233,720,368,547,758.07", "USD 92,233,720,368,547,758.07", "92,233,720,368,547,758.07", "92233720368547758.07"},
+	{math.MinInt64, "USD", "$-92,233,720,368,547,758.08", "USD -92,233,720,368,547,758.08", "-92,233,720,368,547,758.08", "-92233720368547758.08"},
+	{-123456, "AED", "-1,234.56إ.د", "-1,234.56 AED", "-1,234.56", "-1234.56"},
+}
+
+func TestDisplayInt64(t *testing.T) {
+	for _, v := range TestDisplayInt64Data {
+		if result, err := DisplayFullInt64(v.Num, v.Alpha); err != nil && err.Error() != v.Full || err == nil && result != v.Full {
+			t.Error("Expected:", v.Full, "Got:", result, err)
+		}
+		if result, err := DisplayWithAlphaInt64(v.Num, v.Alpha); err != nil && err.Error() != v.Alpha3 || err == nil && result != v.Alpha3 {
+			t.Error("Expected:", v.Alpha3, "Got:", result, err)
+		}
+		if result, err := DisplayNoSymbolInt64(v.Num, v.Alpha); err != nil && err.Error() != v.Symbol || err == nil && result != v.Symbol {
+			t.Error("Expected:", v.Symbol, "Got:", result, err)
+		}
+		if result, err := DisplayWithDecimalInt64(v.Num, v.Alpha); err != nil && err.Error() != v.Decimal || err == nil && result != v.Decimal {
+			t.Error("Expected:", v.Decimal, "Got:", result, err)
+		}
+	}
+}
@@ -1,25 +1,12 @@
 package dough
 
-// Currency - struct containing currency variables
-type Currency struct {
-	Unit                string
-	Alpha               string
-	Numeric             string
-	Symbol              string
-	Fraction            int
-	Decimal             string
-	Grouping            int
-	Delimiter           string
-	SymbolPositionFront bool
-}
-
 // CurrencyList - complete list of supported currencies
 var CurrencyList = map[string]Currency{
 	"AED": {
 		Unit:                "UAE Dirham",
 		Alpha:               "AED",
 		Numeric:             "784",
-		Symbol:              "\u0625\u002E\u062F",
+		Symbol:              "\u0625\u002e\u062f",
 		Fraction:            2,
 		Decimal:             ".",
 		Grouping:            3,
@@ -30,7 +17,7 @@ var CurrencyList = map[string]Currency{
 		Unit:                "Afghani",
 		Alpha:               "AFN",
 		Numeric:             "971",
-		Symbol:              "\u060B",
+		Symbol:              "\u060b",
 		Fraction:            2,
 		Decimal:             ".",
 		Grouping:            3,
@@ -41,7 +28,7 @@ var CurrencyList = map[string]Currency{
 		Unit:                "Lek",
 		Alpha:               "ALL",
 		Numeric:             "008",
-		Symbol:              "\u004c\u0065\u006B",
+		Symbol:              "\u004c\u0065\u006b",
 		Fraction:            2,
 		Decimal:             ".",
 		Grouping:            3,
@@ -52,7 +39,7 @@ var CurrencyList = map[string]Currency{
 		Unit:                "Armenian Dram",
 		Alpha:               "AMD",
 		Numeric:             "051",
-		Symbol:              "\u058F",
+		Symbol:              "\u058f",
 		Fraction:            2,
 		Decimal:             ".",
 		Grouping:            3,
@@ -74,7 +61,7 @@ var CurrencyList = map[string]Currency{
 		Unit:                "Kwanza",
 		Alpha:               "AOA",
 		Numeric:             "973",
-		Symbol:              "\u004B\u007A",
+		Symbol:              "\u004b\u007a",
 		Fraction:            2,
 		Decimal:             ".",
 		Grouping:            3,
@@ -118,7 +105,7 @@ var CurrencyList = map[string]Currency{
 		Unit:                "Azerbaijan Manat",
 		Alpha:               "AZN",
 		Numeric:             "944",
-		Symbol:              "\u20BC",
+		Symbol:              "\u20bc",
 		Fraction:            2,
 		Decimal:             ".",
 		Grouping:            3,
@@ -129,7 +116,7 @@ var CurrencyList = map[string]Currency{
 		Unit:                "Convertible Mark",
 		Alpha:               "BAM",
 		Numeric:             "977",
-		Symbol:              "\u004B\u004D",
+		Symbol:              "\u004b\u004d",
 		Fraction:            2,
 		Decimal:             ".",
 		Grouping:            3,
@@ -151,7 +138,7 @@ var CurrencyList = map[string]Currency{
 		Unit:                "Taka",
 		Alpha:               "BDT",
 		Numeric:             "050",
-		Symbol:              "\u0054\u006B",
+		Symbol:              "\u0054\u006b",
 		Fraction:            2,
 		Decimal:             ".",
 		Grouping:            3,
@@ -162,7 +149,7 @@ var CurrencyList = map[string]Currency{
 		Unit:                "Bulgarian Lev",
 		Alpha:               "BGN",
 		Numeric:             "975",
-		Symbol:              "\u043B\u0432",
+		Symbol:              "\u043b\u0432",
 		Fraction:            2,
 		Decimal:             ".",
 		Grouping:            3,
@@ -224,6 +211,18 @@ var CurrencyList = map[string]Currency{
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
+	"BOV": {
+		Unit:                "Mvdol",
+		Alpha:               "BOV",
+		Numeric:             "984",
+		Symbol:              "\u0042\u004f\u0056",
+		Fraction:            2,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
 	"BRL": {
 		Unit:                "Brazilian Real",
 		Alpha:               "BRL",
@@ -312,6 +311,18 @@ var CurrencyList = map[string]Currency{
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
+	"CHE": {
+		Unit:                "WIR Euro",
+		Alpha:               "CHE",
+		Numeric:             "947",
+		Symbol:              "\u0043\u0048\u0045",
+		Fraction:            2,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
 	"CHF": {
 		Unit:                "Swiss Franc",
 		Alpha:               "CHF",
@@ -323,6 +334,30 @@ var CurrencyList = map[string]Currency{
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
+	"CHW": {
+		Unit:                "WIR Franc",
+		Alpha:               "CHW",
+		Numeric:             "948",
+		Symbol:              "\u0043\u0048\u0057",
+		Fraction:            2,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"CLF": {
+		Unit:                "Unidad de Fomento",
+		Alpha:               "CLF",
+		Numeric:             "990",
+		Symbol:              "\u0043\u004c\u0046",
+		Fraction:            4,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
 	"CLP": {
 		Unit:                "Chilean Peso",
 		Alpha:               "CLP",
@@ -356,6 +391,18 @@ var CurrencyList = map[string]Currency{
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
+	"COU": {
+		Unit:                "Unidad de Valor Real",
+		Alpha:               "COU",
+		Numeric:             "970",
+		Symbol:              "\u0043\u004f\u0055",
+		Fraction:            2,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
 	"CRC": {
 		Unit:                "Costa Rican Colon",
 		Alpha:               "CRC",
@@ -694,6 +741,7 @@ var CurrencyList = map[string]Currency{
 		Fraction:            2,
 		Decimal:             ".",
 		Grouping:            3,
+		SecondaryGrouping:   2,
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
@@ -1060,6 +1108,18 @@ var CurrencyList = map[string]Currency{
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
+	"MXV": {
+		Unit:                "Mexican Unidad de Inversion (UDI)",
+		Alpha:               "MXV",
+		Numeric:             "979",
+		Symbol:              "\u004d\u0058\u0056",
+		Fraction:            2,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
 	"MYR": {
 		Unit:                "Malaysian Ringgit",
 		Alpha:               "MYR",
@@ -1588,6 +1648,30 @@ var CurrencyList = map[string]Currency{
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
+	"USN": {
+		Unit:                "US Dollar (Next day)",
+		Alpha:               "USN",
+		Numeric:             "997",
+		Symbol:              "\u0055\u0053\u004e",
+		Fraction:            2,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"UYI": {
+		Unit:                "Uruguay Peso en Unidades Indexadas (UI)",
+		Alpha:               "UYI",
+		Numeric:             "940",
+		Symbol:              "\u0055\u0059\u0049",
+		Fraction:            0,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
 	"UYU": {
 		Unit:                "Peso Uruguayo",
 		Alpha:               "UYU",
@@ -1599,6 +1683,18 @@ var CurrencyList = map[string]Currency{
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
+	"UYW": {
+		Unit:                "Unidad Previsional",
+		Alpha:               "UYW",
+		Numeric:             "927",
+		Symbol:              "\u0055\u0059\u0057",
+		Fraction:            4,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
 	"UZS": {
 		Unit:                "Uzbekistan Sum",
 		Alpha:               "UZS",
@@ -1665,6 +1761,78 @@ var CurrencyList = map[string]Currency{
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
+	"XAG": {
+		Unit:                "Silver",
+		Alpha:               "XAG",
+		Numeric:             "961",
+		Symbol:              "\u0058\u0041\u0047",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"XAU": {
+		Unit:                "Gold",
+		Alpha:               "XAU",
+		Numeric:             "959",
+		Symbol:              "\u0058\u0041\u0055",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"XBA": {
+		Unit:                "Bond Markets Unit European Composite Unit (EURCO)",
+		Alpha:               "XBA",
+		Numeric:             "955",
+		Symbol:              "\u0058\u0042\u0041",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"XBB": {
+		Unit:                "Bond Markets Unit European Monetary Unit (E.M.U.-6)",
+		Alpha:               "XBB",
+		Numeric:             "956",
+		Symbol:              "\u0058\u0042\u0042",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"XBC": {
+		Unit:                "Bond Markets Unit European Unit of Account 9 (E.U.A.-9)",
+		Alpha:               "XBC",
+		Numeric:             "957",
+		Symbol:              "\u0058\u0042\u0043",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"XBD": {
+		Unit:                "Bond Markets Unit European Unit of Account 17 (E.U.A.-17)",
+		Alpha:               "XBD",
+		Numeric:             "958",
+		Symbol:              "\u0058\u0042\u0044",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
 	"XCD": {
 		Unit:                "East Caribbean Dollar",
 		Alpha:               "XCD",
@@ -1676,6 +1844,18 @@ var CurrencyList = map[string]Currency{
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
+	"XDR": {
+		Unit:                "SDR (Special Drawing Right)",
+		Alpha:               "XDR",
+		Numeric:             "960",
+		Symbol:              "\u0058\u0044\u0052",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
 	"XOF": {
 		Unit:                "CFA Franc BCEAO",
 		Alpha:               "XOF",
@@ -1687,6 +1867,18 @@ var CurrencyList = map[string]Currency{
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
+	"XPD": {
+		Unit:                "Palladium",
+		Alpha:               "XPD",
+		Numeric:             "964",
+		Symbol:              "\u0058\u0050\u0044",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
 	"XPF": {
 		Unit:                "CFP Franc",
 		Alpha:               "XPF",
@@ -1698,6 +1890,66 @@ var CurrencyList = map[string]Currency{
 		Delimiter:           ",",
 		SymbolPositionFront: true,
 	},
+	"XPT": {
+		Unit:                "Platinum",
+		Alpha:               "XPT",
+		Numeric:             "962",
+		Symbol:              "\u0058\u0050\u0054",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"XSU": {
+		Unit:                "Sucre",
+		Alpha:               "XSU",
+		Numeric:             "994",
+		Symbol:              "\u0058\u0053\u0055",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"XTS": {
+		Unit:                "Codes specifically reserved for testing purposes",
+		Alpha:               "XTS",
+		Numeric:             "963",
+		Symbol:              "\u0058\u0054\u0053",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"XUA": {
+		Unit:                "ADB Unit of Account",
+		Alpha:               "XUA",
+		Numeric:             "965",
+		Symbol:              "\u0058\u0055\u0041",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
+	"XXX": {
+		Unit:                "The codes assigned for transactions where no currency is involved",
+		Alpha:               "XXX",
+		Numeric:             "999",
+		Symbol:              "\u0058\u0058\u0058",
+		Fraction:            FractionNA,
+		Decimal:             ".",
+		Grouping:            3,
+		Delimiter:           ",",
+		SymbolPositionFront: false,
+		SymbolSpace:         true,
+	},
 	"YER": {
 		Unit:                "Yemeni Rial",
 		Alpha:               "YER",
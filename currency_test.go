@@ -64,6 +64,17 @@ var TestStringToIntData = []struct {
 	// Non USD
 	{"$100.00,00", "ARS", false, 1000000},
 	{"$10,000,000", "JPY", false, 10000000},
+	{"1.00 SCR", "SCR", false, 100},
+	{"R1.00CR", "ZAR", false, -100},
+	{"$1.00CR", "USD", false, -100},
+	{"1.00SCR", "SCR", false, 100},
+	{"1.00 SCRCR", "SCR", false, -100},
+
+	// No minor unit
+	{"1,000 XAU", "XAU", false, 1000},
+	{"1.5", "XAU", false, ErrorNoMinorUnit.Error()},
+	{"1.5", "XAU", true, ErrorNoMinorUnit.Error()},
+	{"1.2345", "CLF", false, 12345},
 }
 
 func TestStringToInt(t *testing.T) {
@@ -151,6 +162,9 @@ var TestDisplayFullData = []struct {
 	{-1000000, "USD", "$-10,000.00"},
 	{-10000000, "USD", "$-100,000.00"},
 	{-100000000, "USD", "$-1,000,000.00"},
+	{100, "XAU", "100 XAU"},
+	{123456, "CLF", "12.3456 CLF"},
+	{150, "XDR", "150 XDR"},
 }
 
 func TestDisplayFull(t *testing.T) {
@@ -174,6 +188,8 @@ var TestDisplayWithAlphaData = []struct {
 	{0, "USA", ErrorInvalidISO.Error()},
 	{0, "USD", "USD 0.00"},
 	{-1, "USD", "USD -0.01"},
+	{0, "AED", "0.00 AED"},
+	{-123456, "AED", "-1,234.56 AED"},
 }
 
 func TestDisplayWithAlpha(t *testing.T) {
@@ -383,3 +399,154 @@ func TestConversions(t *testing.T) {
 		}
 	}
 }
+
+var TestStringToIntLargeData = []struct {
+	Num    string
+	Alpha  string
+	Output int64
+}{
+	{"$92,233,720,368,547,758.07", "USD", 9223372036854775807},
+	{"-$92,233,720,368,547,758.08", "USD", -9223372036854775808},
+	{"9,223,372,036,854,775.807", "BHD", 9223372036854775807},
+	{"1,234,567,890,123.456", "BHD", 1234567890123456},
+}
+
+func TestStringToIntLarge(t *testing.T) {
+	for _, v := range TestStringToIntLargeData {
+		result, err := StringToInt64(v.Num, v.Alpha)
+		if err != nil {
+			t.Error(err)
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+func TestStringToIntOverflow(t *testing.T) {
+	_, err := StringToInt("$92,233,720,368,547,758.08", "USD")
+	if err != ErrorAmountOverflow {
+		t.Error("Expected:", ErrorAmountOverflow, "Got:", err)
+	}
+}
+
+var TestIndianGroupingData = []struct {
+	Amount int
+	Output string
+}{
+	{0, "₹0.00"},
+	{100000, "₹1,000.00"},
+	{10000000, "₹1,00,000.00"},
+	{123456700, "₹12,34,567.00"},
+	{-1234567800, "₹-1,23,45,678.00"},
+}
+
+func TestIndianGrouping(t *testing.T) {
+	for _, v := range TestIndianGroupingData {
+		result, err := DisplayFull(v.Amount, "INR")
+		if err != nil {
+			t.Error(err)
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+
+		parsed, err := StringToInt(v.Output, "INR")
+		if err != nil {
+			t.Error(err)
+		} else if parsed != v.Amount {
+			t.Error("Expected:", v.Amount, "Got:", parsed)
+		}
+	}
+}
+
+var TestNegativeStyleData = []struct {
+	Amount int
+	Alpha  string
+	Style  negative
+	Output string
+}{
+	{-100, "USD", NegativeAfterSymbol, "$-1.00"},
+	{-100, "USD", NegativeLeading, "-$1.00"},
+	{-100, "USD", NegativeTrailing, "$1.00-"},
+	{-100, "USD", NegativeParentheses, "($1.00)"},
+	{-100, "USD", NegativeCredit, "$1.00 CR"},
+	{-123456, "USD", "", "$-1,234.56"},
+	{123456, "USD", NegativeParentheses, "$1,234.56"},
+	{-100, "AED", NegativeAfterSymbol, "-1.00إ.د"},
+	{-100, "AED", NegativeLeading, "-1.00إ.د"},
+	{-100, "AED", NegativeTrailing, "1.00إ.د-"},
+	{-100, "AED", NegativeParentheses, "(1.00إ.د)"},
+	{-100, "AED", NegativeCredit, "1.00إ.د CR"},
+	{-100, "ZAR", NegativeCredit, "R1.00 CR"},
+	{-100, "ZAR", NegativeParentheses, "(R1.00)"},
+	{-100, "SCR", NegativeCredit, "₨1.00 CR"},
+	{100, "SCR", NegativeCredit, "₨1.00"},
+	{-1000, "JPY", NegativeParentheses, "(¥1000)"},
+	{-100000, "ARS", NegativeTrailing, "$1.000,00-"},
+}
+
+func TestNegativeStyle(t *testing.T) {
+	for _, v := range TestNegativeStyleData {
+		result, err := DisplayFullNegative(v.Amount, v.Alpha, v.Style)
+		if err != nil {
+			t.Error(err)
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+
+		parsed, err := StringToInt(v.Output, v.Alpha)
+		if err != nil {
+			t.Error(err)
+		} else if parsed != v.Amount {
+			t.Error("Expected:", v.Amount, "Got:", parsed, "From:", v.Output)
+		}
+	}
+
+	if _, err := DisplayFullNegative(-100, "USA", NegativeLeading); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+}
+
+var TestStringToIntNegativeData = []struct {
+	Num    string
+	Output interface{}
+}{
+	{"1.00 cr", -100},
+	{" ( $1.00 ) ", -100},
+	{"1.00-", -100},
+	{"CR", ErrorInvalidStringFormat.Error()},
+	{"()", ErrorInvalidStringFormat.Error()},
+	{"(-$1.00)", ErrorInvalidStringFormat.Error()},
+	{"-$1.00 CR", ErrorInvalidStringFormat.Error()},
+	{"-$1.00-", ErrorInvalidStringFormat.Error()},
+}
+
+func TestStringToIntNegative(t *testing.T) {
+	for _, v := range TestStringToIntNegativeData {
+		result, err := StringToInt(v.Num, "USD")
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result, "From:", v.Num)
+		}
+	}
+}
+
+func TestNoMinorUnitCurrencies(t *testing.T) {
+	for _, alpha := range []string{"XAU", "XAG", "XPT", "XPD", "XDR", "XSU", "XUA", "XBA", "XBB", "XBC", "XBD", "XTS", "XXX"} {
+		ISO, err := GetISOFromAlpha(alpha)
+		if err != nil || ISO.Fraction != FractionNA || ISO.MinorDigits() != 0 {
+			t.Error("Expected:", alpha, "with no minor unit", "Got:", ISO, err)
+		}
+	}
+	for alpha, fraction := range map[string]int{"BOV": 2, "CHE": 2, "CHW": 2, "CLF": 4, "COU": 2, "MXV": 2, "USN": 2, "UYI": 0, "UYW": 4} {
+		ISO, err := GetISOFromAlpha(alpha)
+		if err != nil || ISO.Fraction != fraction || ISO.MinorDigits() != fraction {
+			t.Error("Expected:", alpha, "with fraction", fraction, "Got:", ISO, err)
+		}
+	}
+	if alpha, err := GetAlphaFromISONumeric("959"); err != nil || alpha != "XAU" {
+		t.Error("Expected:", "XAU", "Got:", alpha, err)
+	}
+}
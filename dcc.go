@@ -0,0 +1,105 @@
+package dough
+
+import (
+	"math/big"
+)
+
+// DCCQuote - a dynamic currency conversion offer to show a cardholder before they choose to pay in their own currency
+type DCCQuote struct {
+	Amount        int     // minor units of From
+	From          string  // the merchant currency
+	To            string  // the cardholder currency
+	QuotedAmount  int     // minor units of To charged to the cardholder, markup included
+	MarkupAmount  int     // minor units of To the markup adds over the reference rate
+	ReferenceRate Rate    // the rate from the provider
+	Markup        Decimal // percent over the reference rate, such as 3.5
+	EffectiveRate Decimal // the reference rate with the markup applied
+	Disclosure    string
+}
+
+// QuoteDCC : returns a dynamic currency conversion quote for an amount in minor units with a markup percent over the provider rate
+//
+// The effective rate is the reference rate increased by the markup, so a 3.5 markup on 0.9215 is
+// 0.9537525. The quoted amount and the amount at the reference rate are each rounded to the fraction
+// of "to" based upon the round mode, and the markup amount is their difference. The disclosure states
+// both amounts with DisplayWithAlpha, the effective and reference rates and the markup percentage.
+func QuoteDCC(amount int, from string, to string, provider ExchangeRateProvider, markup Decimal, mode round) (DCCQuote, error) {
+	if markup.Sign() < 0 {
+		return DCCQuote{}, ErrorInvalidMarkup
+	}
+	fromISO, err := GetISOFromAlpha(from)
+	if err != nil {
+		return DCCQuote{}, err
+	}
+	toISO, err := GetISOFromAlpha(to)
+	if err != nil {
+		return DCCQuote{}, err
+	}
+	if fromISO.Alpha == toISO.Alpha {
+		return DCCQuote{}, ErrorInvalidDCC
+	}
+
+	rate, err := providerRate(provider, fromISO.Alpha, toISO.Alpha)
+	if err != nil {
+		return DCCQuote{}, err
+	}
+
+	// effective = reference * (100 + markup) / 100, which is exact in base ten
+	effective := rate.Value.Mul(NewDecimal(100, 0).Add(markup)).Shift(-2)
+	value := NewDecimal(int64(amount), fromISO.MinorDigits())
+	quoted := value.Mul(effective).MinorUnits(toISO.MinorDigits(), mode)
+	reference := value.Mul(rate.Value).MinorUnits(toISO.MinorDigits(), mode)
+	if !fitsInt(quoted) || !fitsInt(reference) {
+		return DCCQuote{}, ErrorArithmeticOverflow
+	}
+
+	quote := DCCQuote{
+		Amount:        amount,
+		From:          fromISO.Alpha,
+		To:            toISO.Alpha,
+		QuotedAmount:  int(quoted.Int64()),
+		MarkupAmount:  int(new(big.Int).Sub(quoted, reference).Int64()),
+		ReferenceRate: rate,
+		Markup:        markup,
+		EffectiveRate: effective,
+	}
+	quote.Disclosure, err = quote.disclose()
+	if err != nil {
+		return DCCQuote{}, err
+	}
+	return quote, nil
+}
+
+// QuoteDCC : returns a dynamic currency conversion quote for the money, see QuoteDCC
+func (m Money) QuoteDCC(to string, provider ExchangeRateProvider, markup Decimal, mode round) (DCCQuote, error) {
+	if !fitsInt(big.NewInt(m.amount)) {
+		return DCCQuote{}, ErrorArithmeticOverflow
+	}
+	return QuoteDCC(int(m.amount), m.currency.Alpha, to, provider, markup, mode)
+}
+
+// disclose : returns the disclosure, such as "USD 100.00 = EUR 95.38 at 1 USD = 0.9538 EUR, including a 3.50% markup
+// of EUR 3.23 over the reference rate of 1 USD = 0.9215 EUR"
+func (q DCCQuote) disclose() (string, error) {
+	base, err := DisplayWithAlpha(q.Amount, q.From)
+	if err != nil {
+		return "", err
+	}
+	quoted, err := DisplayWithAlpha(q.QuotedAmount, q.To)
+	if err != nil {
+		return "", err
+	}
+	markup, err := DisplayWithAlpha(q.MarkupAmount, q.To)
+	if err != nil {
+		return "", err
+	}
+
+	// Show the effective rate with at least as many digits as the reference rate, and no fewer than four
+	scale := q.ReferenceRate.Value.Scale()
+	if scale < 4 {
+		scale = 4
+	}
+	return base + " = " + quoted + " at 1 " + q.From + " = " + q.EffectiveRate.Round(scale, Round).String() + " " + q.To +
+		", including a " + q.Markup.Round(2, Round).String() + "% markup of " + markup +
+		" over the reference rate of 1 " + q.From + " = " + q.ReferenceRate.Value.String() + " " + q.To, nil
+}
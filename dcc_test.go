@@ -0,0 +1,70 @@
+package dough
+
+import (
+	"testing"
+)
+
+var TestQuoteDCCData = []struct {
+	Amount int
+	From   string
+	To     string
+	Markup Decimal
+	Round  round
+	Output interface{}
+}{
+	{10000, "USD", "EUR", NewDecimal(35, 1), Round, [2]int{9538, 323}},
+	{10000, "USD", "EUR", NewDecimal(35, 1), Floor, [2]int{9537, 322}},
+	{10000, "usd", "eur", NewDecimal(0, 0), Round, [2]int{9215, 0}},
+	{10000, "USD", "JPY", NewDecimal(3, 0), Round, [2]int{16451, 479}},
+	{2500, "USD", "KWD", NewDecimal(275, 2), Bankers, [2]int{7877, 211}},
+	{-10000, "USD", "EUR", NewDecimal(35, 1), Round, [2]int{-9538, -323}},
+	{10000, "USD", "EUR", NewDecimal(-1, 0), Round, ErrorInvalidMarkup.Error()},
+	{10000, "USD", "USD", NewDecimal(3, 0), Round, ErrorInvalidDCC.Error()},
+	{10000, "EUR", "USD", NewDecimal(3, 0), Round, ErrorRateNotFound.Error()},
+	{10000, "USA", "EUR", NewDecimal(3, 0), Round, ErrorInvalidISO.Error()},
+	{maxInt, "USD", "JPY", NewDecimal(3, 0), Round, ErrorArithmeticOverflow.Error()},
+}
+
+func TestQuoteDCC(t *testing.T) {
+	p := testRates(t)
+	for _, v := range TestQuoteDCCData {
+		quote, err := QuoteDCC(v.Amount, v.From, v.To, p, v.Markup, v.Round)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error("Expected:", v.Output, "Got:", err)
+			}
+		} else if [2]int{quote.QuotedAmount, quote.MarkupAmount} != v.Output {
+			t.Error("Expected:", v.Output, "Got:", quote.QuotedAmount, quote.MarkupAmount)
+		}
+	}
+}
+
+func TestQuoteDCCDisclosure(t *testing.T) {
+	p := testRates(t)
+	quote, err := QuoteDCC(10000, "USD", "EUR", p, NewDecimal(35, 1), Round)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "USD 100.00 = EUR 95.38 at 1 USD = 0.9538 EUR, including a 3.50% markup of EUR 3.23 over the reference rate of 1 USD = 0.9215 EUR"
+	if quote.Disclosure != expected {
+		t.Error("Expected:", expected, "Got:", quote.Disclosure)
+	}
+	if quote.EffectiveRate.String() != "0.9537525" || quote.ReferenceRate.Value.String() != "0.9215" || !quote.ReferenceRate.Timestamp.Equal(quoted) {
+		t.Error("Expected:", "0.9537525 over 0.9215", "Got:", quote.EffectiveRate, quote.ReferenceRate)
+	}
+
+	quote, err = QuoteDCC(2500, "USD", "KWD", p, NewDecimal(275, 2), Bankers)
+	expected = "USD 25.00 = KWD 7.877 at 1 USD = 0.31508 KWD, including a 2.75% markup of KWD 0.211 over the reference rate of 1 USD = 0.30665 KWD"
+	if err != nil || quote.Disclosure != expected {
+		t.Error("Expected:", expected, "Got:", quote.Disclosure, err)
+	}
+}
+
+func TestMoneyQuoteDCC(t *testing.T) {
+	p := testRates(t)
+	m, _ := NewMoney(10000, "USD")
+	quote, err := m.QuoteDCC("EUR", p, NewDecimal(35, 1), Round)
+	if err != nil || quote.QuotedAmount != 9538 || quote.From != "USD" || quote.To != "EUR" {
+		t.Error("Expected:", 9538, "Got:", quote, err)
+	}
+}
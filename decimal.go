@@ -0,0 +1,248 @@
+package dough
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal - an exact base ten number stored as an integer coefficient and the number of digits after the decimal point
+type Decimal struct {
+	coef  *big.Int
+	scale int
+}
+
+var bigTen = big.NewInt(10)
+
+// powers - cached powers of ten for the scales currencies and rates commonly use
+var powers = func() []*big.Int {
+	output := make([]*big.Int, 40)
+	output[0] = big.NewInt(1)
+	for key := 1; key < len(output); key++ {
+		output[key] = new(big.Int).Mul(output[key-1], bigTen)
+	}
+	return output
+}()
+
+// pow10 : returns 10^n as a big.Int that must not be modified
+func pow10(n int) *big.Int {
+	if n < len(powers) {
+		return powers[n]
+	}
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// NewDecimal : returns a Decimal of coef * 10^-scale, so NewDecimal(1999, 2) is 19.99
+func NewDecimal(coef int64, scale int) Decimal {
+	return newDecimal(big.NewInt(coef), scale)
+}
+
+// newDecimal : returns a Decimal that takes ownership of coef, moving a negative scale into the coefficient
+func newDecimal(coef *big.Int, scale int) Decimal {
+	if scale < 0 {
+		coef = new(big.Int).Mul(coef, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{coef: coef, scale: scale}
+}
+
+// ParseDecimal : returns a Decimal from a plain decimal string such as "-1234.5678"
+func ParseDecimal(str string) (Decimal, error) {
+	digits := str
+	negative := false
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		negative = digits[0] == '-'
+		digits = digits[1:]
+	}
+
+	// Accumulate in a uint64 and only fall back to big.Int for very long inputs
+	var small uint64
+	var coef *big.Int
+	count, scale, point := 0, 0, false
+	for key := 0; key < len(digits); key++ {
+		c := digits[key]
+		if c == '.' && !point {
+			point = true
+			continue
+		}
+		if c < '0' || c > '9' {
+			return Decimal{}, ErrorInvalidStringFormat
+		}
+		if point {
+			scale++
+		}
+		count++
+		if coef == nil && count <= 18 {
+			small = small*10 + uint64(c-'0')
+			continue
+		}
+		if coef == nil {
+			coef = new(big.Int).SetUint64(small)
+		}
+		coef.Mul(coef, bigTen).Add(coef, big.NewInt(int64(c-'0')))
+	}
+	if count == 0 {
+		return Decimal{}, ErrorInvalidStringFormat
+	}
+	if coef == nil {
+		coef = new(big.Int).SetUint64(small)
+	}
+	if negative {
+		coef.Neg(coef)
+	}
+	return Decimal{coef: coef, scale: scale}, nil
+}
+
+// DecimalFromFloat : returns the shortest Decimal that converts back to the same float
+func DecimalFromFloat(amt float64) (Decimal, error) {
+	if math.IsNaN(amt) || math.IsInf(amt, 0) {
+		return Decimal{}, ErrorInvalidFloat
+	}
+	return ParseDecimal(strconv.FormatFloat(amt, 'f', -1, 64))
+}
+
+// int : returns the coefficient, treating the zero value as zero
+func (d Decimal) int() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// rescale : returns the coefficient of d expressed with a larger scale
+func (d Decimal) rescale(scale int) *big.Int {
+	return new(big.Int).Mul(d.int(), pow10(scale-d.scale))
+}
+
+// Scale : returns the number of digits after the decimal point
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+// Sign : returns -1, 0 or 1 based on whether the decimal is negative, zero or positive
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero : returns whether the decimal is zero
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp : returns -1, 0 or 1 if d is less than, equal to or greater than other
+func (d Decimal) Cmp(other Decimal) int {
+	scale := d.scale
+	if other.scale > scale {
+		scale = other.scale
+	}
+	return d.rescale(scale).Cmp(other.rescale(scale))
+}
+
+// Neg : returns the decimal with its sign flipped
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs : returns the absolute value of the decimal
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Add : returns d + other
+func (d Decimal) Add(other Decimal) Decimal {
+	scale := d.scale
+	if other.scale > scale {
+		scale = other.scale
+	}
+	return Decimal{coef: new(big.Int).Add(d.rescale(scale), other.rescale(scale)), scale: scale}
+}
+
+// Sub : returns d - other
+func (d Decimal) Sub(other Decimal) Decimal {
+	return d.Add(other.Neg())
+}
+
+// Mul : returns d * other
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.int(), other.int()), scale: d.scale + other.scale}
+}
+
+// Quo : returns d / other rounded to scale digits after the decimal point based upon the round mode,
+// where a negative scale such as FractionNA rounds to whole units
+func (d Decimal) Quo(other Decimal, scale int, mode round) (Decimal, error) {
+	if other.IsZero() {
+		return Decimal{}, ErrorDivisionByZero
+	}
+	scale = wholeScale(scale)
+	// d / other = (d.coef * 10^(scale + other.scale - d.scale)) / other.coef * 10^-scale
+	num := new(big.Int).Set(d.int())
+	shift := scale + other.scale - d.scale
+	if shift >= 0 {
+		num.Mul(num, pow10(shift))
+		return newDecimal(divRound(num, other.int(), mode), scale), nil
+	}
+	return newDecimal(divRound(num, new(big.Int).Mul(other.int(), pow10(-shift)), mode), scale), nil
+}
+
+// Shift : returns the decimal multiplied by 10^places, so Shift(-2) divides by one hundred
+func (d Decimal) Shift(places int) Decimal {
+	return newDecimal(new(big.Int).Set(d.int()), d.scale-places)
+}
+
+// Round : returns the decimal rounded to scale digits after the decimal point based upon the round mode,
+// where a negative scale such as FractionNA rounds to whole units
+func (d Decimal) Round(scale int, mode round) Decimal {
+	scale = wholeScale(scale)
+	if scale >= d.scale {
+		return Decimal{coef: d.rescale(scale), scale: scale}
+	}
+	return Decimal{coef: divRound(d.int(), pow10(d.scale-scale), mode), scale: scale}
+}
+
+// MinorUnits : returns the decimal as a count of minor units for the fraction, rounded based upon the round mode
+func (d Decimal) MinorUnits(fraction int, mode round) *big.Int {
+	return d.Round(fraction, mode).coef
+}
+
+// String : returns the decimal with all of its scale digits, for example "-0.050"
+func (d Decimal) String() string {
+	coef := d.int()
+	str := insertDecimalPoint(new(big.Int).Abs(coef).String(), d.scale)
+	if coef.Sign() < 0 {
+		return "-" + str
+	}
+	return str
+}
+
+// Float64 : returns the nearest float to the decimal
+func (d Decimal) Float64() float64 {
+	// Both operands are exact floats here so the division is correctly rounded
+	coef := d.int()
+	if coef.IsInt64() && d.scale <= 22 {
+		if c := coef.Int64(); c < 1<<53 && c > -1<<53 {
+			return float64(c) / math.Pow10(d.scale)
+		}
+	}
+	fl, _ := strconv.ParseFloat(d.String(), 64)
+	return fl
+}
+
+// wholeScale : returns a scale or fraction of at least zero, so FractionNA counts whole units
+func wholeScale(scale int) int {
+	if scale < 0 {
+		return 0
+	}
+	return scale
+}
+
+// insertDecimalPoint : returns a string of unsigned digits with a "." placed fraction digits from the right, zero padded
+func insertDecimalPoint(digits string, fraction int) string {
+	if fraction <= 0 {
+		return digits
+	}
+	if len(digits) <= fraction {
+		digits = strings.Repeat("0", fraction-len(digits)+1) + digits
+	}
+	return digits[:len(digits)-fraction] + "." + digits[len(digits)-fraction:]
+}
@@ -0,0 +1,155 @@
+package dough
+
+import (
+	"math"
+	"testing"
+)
+
+var TestParseDecimalData = []struct {
+	Input  string
+	Output string
+}{
+	{"", ErrorInvalidStringFormat.Error()},
+	{".", ErrorInvalidStringFormat.Error()},
+	{"-", ErrorInvalidStringFormat.Error()},
+	{"1.2.3", ErrorInvalidStringFormat.Error()},
+	{"1-2", ErrorInvalidStringFormat.Error()},
+	{"12$34", ErrorInvalidStringFormat.Error()},
+	{"0", "0"},
+	{"-0.50", "-0.50"},
+	{"+12.345", "12.345"},
+	{"5.", "5"},
+	{".5", "0.5"},
+	{"0001.10", "1.10"},
+	{"9223372036854775807", "9223372036854775807"},
+	{"-92233720368547758.08", "-92233720368547758.08"},
+	{"123456789012345678901234567890.123456789012345678", "123456789012345678901234567890.123456789012345678"},
+}
+
+func TestParseDecimal(t *testing.T) {
+	for _, v := range TestParseDecimalData {
+		result, err := ParseDecimal(v.Input)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result.String() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result.String())
+		}
+	}
+}
+
+var TestDecimalFromFloatData = []struct {
+	Input  float64
+	Output string
+}{
+	{math.NaN(), ErrorInvalidFloat.Error()},
+	{math.Inf(1), ErrorInvalidFloat.Error()},
+	{0, "0"},
+	{0.1, "0.1"},
+	{-17.99, "-17.99"},
+	{1.005, "1.005"},
+	{123456789.99, "123456789.99"},
+}
+
+func TestDecimalFromFloat(t *testing.T) {
+	for _, v := range TestDecimalFromFloatData {
+		result, err := DecimalFromFloat(v.Input)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result.String() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result.String())
+		}
+	}
+}
+
+var TestDecimalRoundData = []struct {
+	Input  string
+	Scale  int
+	Round  round
+	Output string
+}{
+	{"1.005", 2, Round, "1.01"},
+	{"1.005", 2, Floor, "1.00"},
+	{"1.005", 2, Ceil, "1.01"},
+	{"1.005", 2, Bankers, "1.00"},
+	{"1.015", 2, Bankers, "1.02"},
+	{"-1.005", 2, Round, "-1.01"},
+	{"-1.005", 2, Floor, "-1.01"},
+	{"-1.005", 2, Ceil, "-1.00"},
+	{"1.5", 4, Round, "1.5000"},
+	{"99.5", 0, Round, "100"},
+	{"15", FractionNA, Round, "15"},
+	{"14.5", -1, Round, "15"},
+	{"14.5", -3, Floor, "14"},
+}
+
+func TestDecimalRound(t *testing.T) {
+	for _, v := range TestDecimalRoundData {
+		dec, _ := ParseDecimal(v.Input)
+		result := dec.Round(v.Scale, v.Round)
+		if result.String() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result.String())
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a, _ := ParseDecimal("10.25")
+	b, _ := ParseDecimal("-0.125")
+
+	if result := a.Add(b).String(); result != "10.125" {
+		t.Error(result)
+	}
+	if result := a.Sub(b).String(); result != "10.375" {
+		t.Error(result)
+	}
+	if result := a.Mul(b).String(); result != "-1.28125" {
+		t.Error(result)
+	}
+	if result := a.Shift(-3).String(); result != "0.01025" {
+		t.Error(result)
+	}
+	if result := a.Shift(3).String(); result != "10250" {
+		t.Error(result)
+	}
+	if result := b.Abs().String(); result != "0.125" {
+		t.Error(result)
+	}
+	if result := b.Neg().String(); result != "0.125" {
+		t.Error(result)
+	}
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || a.Cmp(NewDecimal(102500, 4)) != 0 {
+		t.Error("Cmp failed")
+	}
+	if a.Sign() != 1 || b.Sign() != -1 || !(Decimal{}).IsZero() {
+		t.Error("Sign failed")
+	}
+
+	result, err := a.Quo(NewDecimal(3, 0), 4, Round)
+	if err != nil || result.String() != "3.4167" {
+		t.Error(result, err)
+	}
+	result, err = NewDecimal(1, 0).Quo(NewDecimal(8, 0), 2, Bankers)
+	if err != nil || result.String() != "0.12" {
+		t.Error(result, err)
+	}
+	result, err = NewDecimal(123456, 6).Quo(NewDecimal(2, 0), 2, Floor)
+	if err != nil || result.String() != "0.06" {
+		t.Error(result, err)
+	}
+	if _, err := a.Quo(Decimal{}, 2, Round); err != ErrorDivisionByZero {
+		t.Error("Expected:", ErrorDivisionByZero, "Got:", err)
+	}
+}
+
+func TestDecimalFloat64(t *testing.T) {
+	if result := NewDecimal(1999, 2).Float64(); result != 19.99 {
+		t.Error(result)
+	}
+	if result := NewDecimal(math.MaxInt64, 18).Float64(); result != 9.223372036854775807 {
+		t.Error(result)
+	}
+}
@@ -11,8 +11,79 @@ var ErrorInvalidStringFormat = errors.New("Invalid String Format")
 // ErrorInvalidISOFractionMatch : returns an error if fraction does not match ISO fraction
 var ErrorInvalidISOFractionMatch = errors.New("Invalid ISO Fraction Match")
 
+// ErrorAmountOverflow : returns an error if a parsed amount does not fit in the requested integer type
+var ErrorAmountOverflow = errors.New("Amount Overflows Integer Range")
+
 // ErrorUnableToFormatCurrency : returns an error for invalid currency formatting
 var ErrorUnableToFormatCurrency = errors.New("Unable To Format Currency")
 
 // ErrorUnableToFormatCurrencyFromString : returns an error for invalid formatting from a string
 var ErrorUnableToFormatCurrencyFromString = errors.New("Unable To Format Currency From String")
+
+// CurrencyMismatchError : returns an error when an operation is attempted across two different currencies
+type CurrencyMismatchError struct {
+	Expected string
+	Actual   string
+}
+
+// Error : returns the mismatch as a readable string
+func (e CurrencyMismatchError) Error() string {
+	return "Currency Mismatch: expected " + e.Expected + " got " + e.Actual
+}
+
+// ErrorArithmeticOverflow : returns an error if an arithmetic result does not fit in an int64
+var ErrorArithmeticOverflow = errors.New("Arithmetic Overflow")
+
+// ErrorDivisionByZero : returns an error if a ratio has a zero denominator
+var ErrorDivisionByZero = errors.New("Division By Zero")
+
+// ErrorInvalidAllocation : returns an error if an amount cannot be allocated across the given ratios or parties
+var ErrorInvalidAllocation = errors.New("Invalid Allocation")
+
+// ErrorInvalidFloat : returns an error if a float is NaN or infinite
+var ErrorInvalidFloat = errors.New("Invalid Float")
+
+// ErrorInvalidLocale : returns an error for an unknown locale tag
+var ErrorInvalidLocale = errors.New("Invalid Locale")
+
+// ErrorInvalidLanguage : returns an error for a language with no registered speller
+var ErrorInvalidLanguage = errors.New("Invalid Language")
+
+// ErrorCurrencyNotDetected : returns an error if no currency code or symbol is found in a string
+var ErrorCurrencyNotDetected = errors.New("Currency Not Detected")
+
+// ErrorInvalidSymbol : returns an error if no currency uses a symbol or a currency does not use the given symbol
+var ErrorInvalidSymbol = errors.New("Invalid Symbol")
+
+// ErrorInvalidCurrency : returns an error if a currency cannot be registered, such as a missing alpha code or a numeric code in use
+var ErrorInvalidCurrency = errors.New("Invalid Currency")
+
+// ErrorInvalidISO4217 : returns an error if ISO 4217 list one data cannot be read
+var ErrorInvalidISO4217 = errors.New("Invalid ISO 4217 Data")
+
+// ErrorCurrencyInactive : returns an error if a currency was not in use on the date asked for
+var ErrorCurrencyInactive = errors.New("Currency Not Active On Date")
+
+// ErrorInvalidCountry : returns an error for an ISO 3166 country code with no known currency
+var ErrorInvalidCountry = errors.New("Invalid Country")
+
+// ErrorNoMinorUnit : returns an error if an amount has a fraction but the currency has no minor unit, such as XAU
+var ErrorNoMinorUnit = errors.New("Currency Has No Minor Unit")
+
+// ErrorRateNotFound : returns an error if an exchange rate provider has no rate for a currency pair
+var ErrorRateNotFound = errors.New("Exchange Rate Not Found")
+
+// ErrorInvalidRate : returns an error if an exchange rate is zero or negative
+var ErrorInvalidRate = errors.New("Invalid Exchange Rate")
+
+// ErrorNoRatePath : returns an error if no direct, inverse or pivot exchange rate connects two currencies
+var ErrorNoRatePath = errors.New("No Exchange Rate Path")
+
+// ErrorInvalidRateFile : returns an error if an exchange rate file cannot be read, such as a malformed date or rate
+var ErrorInvalidRateFile = errors.New("Invalid Exchange Rate File")
+
+// ErrorInvalidMarkup : returns an error if a currency conversion markup is negative
+var ErrorInvalidMarkup = errors.New("Invalid Markup")
+
+// ErrorInvalidDCC : returns an error if a dynamic currency conversion is quoted into the currency it is from
+var ErrorInvalidDCC = errors.New("Invalid Dynamic Currency Conversion")
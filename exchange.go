@@ -0,0 +1,151 @@
+package dough
+
+import (
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rate - the price of one major unit of From in major units of To and when it was quoted
+type Rate struct {
+	From      string
+	To        string
+	Value     Decimal
+	Timestamp time.Time
+}
+
+// ExchangeRateProvider - a source of exchange rates, such as a rate feed or StaticRateProvider
+//
+// Rate is called with ISO three digit alphabetic codes in upper case and returns ErrorRateNotFound
+// when it has no rate for the pair.
+type ExchangeRateProvider interface {
+	Rate(from string, to string) (Rate, error)
+}
+
+// StaticRateProvider - a concurrency safe, in memory table of exchange rates, mainly for tests
+type StaticRateProvider struct {
+	mu    sync.RWMutex
+	rates map[string]Rate // keyed by "FROM/TO"
+}
+
+// NewStaticRateProvider : returns an empty StaticRateProvider
+func NewStaticRateProvider() *StaticRateProvider {
+	return &StaticRateProvider{rates: map[string]Rate{}}
+}
+
+// SetRate : sets the rate from one currency to another, replacing any rate already set for the pair
+func (p *StaticRateProvider) SetRate(from string, to string, value Decimal, timestamp time.Time) error {
+	rate, err := validRate(Rate{From: from, To: to, Value: value, Timestamp: timestamp})
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rates[ratePair(rate.From, rate.To)] = rate
+	return nil
+}
+
+// Rate : returns the rate set from one currency to another or ErrorRateNotFound
+func (p *StaticRateProvider) Rate(from string, to string) (Rate, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	rate, ok := p.rates[ratePair(strings.ToUpper(from), strings.ToUpper(to))]
+	if !ok {
+		return Rate{}, ErrorRateNotFound
+	}
+	return rate, nil
+}
+
+// Convert : returns an amount in minor units of one currency converted to minor units of another, with the rate used
+//
+// The amount is read using the fraction of "from", multiplied by the rate exactly and rounded to the
+// fraction of "to" based upon the round mode, so 1000 USD cents at 0.9215 is 922 EUR cents with Round.
+// Converting a currency to itself returns the amount with a rate of 1 and does not ask the provider.
+// A result past the int range returns ErrorArithmeticOverflow, as Money.Convert does past int64.
+func Convert(amount int, from string, to string, provider ExchangeRateProvider, mode round) (int, Rate, error) {
+	result, rate, _, err := convert(int64(amount), from, to, provider, mode)
+	if err != nil {
+		return 0, Rate{}, err
+	}
+	if !fitsInt(result) {
+		return 0, Rate{}, ErrorArithmeticOverflow
+	}
+	return int(result.Int64()), rate, nil
+}
+
+// Convert : returns the money converted to another currency with the rate used, see Convert
+func (m Money) Convert(to string, provider ExchangeRateProvider, mode round) (Money, Rate, error) {
+	result, rate, ISO, err := convert(m.amount, m.currency.Alpha, to, provider, mode)
+	if err != nil {
+		return Money{}, Rate{}, err
+	}
+	if !result.IsInt64() {
+		return Money{}, Rate{}, ErrorArithmeticOverflow
+	}
+	return Money{amount: result.Int64(), currency: ISO}, rate, nil
+}
+
+// convert : returns the converted amount in minor units, the rate used and the currency converted to
+func convert(amount int64, from string, to string, provider ExchangeRateProvider, mode round) (*big.Int, Rate, Currency, error) {
+	fromISO, err := GetISOFromAlpha(from)
+	if err != nil {
+		return nil, Rate{}, Currency{}, err
+	}
+	toISO, err := GetISOFromAlpha(to)
+	if err != nil {
+		return nil, Rate{}, Currency{}, err
+	}
+	if fromISO.Alpha == toISO.Alpha {
+		return big.NewInt(amount), Rate{From: fromISO.Alpha, To: toISO.Alpha, Value: NewDecimal(1, 0)}, toISO, nil
+	}
+
+	rate, err := providerRate(provider, fromISO.Alpha, toISO.Alpha)
+	if err != nil {
+		return nil, Rate{}, Currency{}, err
+	}
+	return NewDecimal(amount, fromISO.MinorDigits()).Mul(rate.Value).MinorUnits(toISO.MinorDigits(), mode), rate, toISO, nil
+}
+
+// providerRate : returns the rate a provider gives for a pair, filling in codes it left empty, or
+// ErrorInvalidRate if the rate is for another pair or not above zero
+func providerRate(provider ExchangeRateProvider, from string, to string) (Rate, error) {
+	rate, err := provider.Rate(from, to)
+	if err != nil {
+		return Rate{}, err
+	}
+	if rate.From == "" {
+		rate.From = from
+	}
+	if rate.To == "" {
+		rate.To = to
+	}
+	rate, err = validRate(rate)
+	if err != nil || rate.From != from || rate.To != to {
+		return Rate{}, ErrorInvalidRate
+	}
+	return rate, nil
+}
+
+// ratePair : returns the key of a currency pair
+func ratePair(from string, to string) string {
+	return from + "/" + to
+}
+
+// validRate : returns a rate with its codes checked against GetISOFromAlpha and upper cased
+func validRate(rate Rate) (Rate, error) {
+	fromISO, err := GetISOFromAlpha(rate.From)
+	if err != nil {
+		return Rate{}, err
+	}
+	toISO, err := GetISOFromAlpha(rate.To)
+	if err != nil {
+		return Rate{}, err
+	}
+	if rate.Value.Sign() <= 0 {
+		return Rate{}, ErrorInvalidRate
+	}
+	rate.From, rate.To = fromISO.Alpha, toISO.Alpha
+	return rate, nil
+}
@@ -0,0 +1,138 @@
+package dough
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+var quoted = time.Date(2024, 6, 25, 16, 0, 0, 0, time.UTC)
+
+// testRates returns a StaticRateProvider with rates from USD
+func testRates(t *testing.T) *StaticRateProvider {
+	p := NewStaticRateProvider()
+	for to, value := range map[string]string{"EUR": "0.9215", "JPY": "159.72", "BHD": "0.376", "KWD": "0.30665", "XAU": "0.000431"} {
+		rate, err := ParseDecimal(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := p.SetRate("USD", to, rate, quoted); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return p
+}
+
+var TestConvertData = []struct {
+	Amount int
+	From   string
+	To     string
+	Round  round
+	Output interface{}
+}{
+	{1000, "USD", "EUR", Round, 922},
+	{1000, "USD", "EUR", Floor, 921},
+	{-1000, "usd", "eur", Round, -922},
+	{1000, "USD", "JPY", Round, 1597},
+	{1000, "USD", "BHD", Round, 3760},
+	{5, "USD", "KWD", Bankers, 15},
+	{15, "USD", "KWD", Bankers, 46},
+	{100000000, "USD", "XAU", Round, 431},
+	{1234, "USD", "USD", Round, 1234},
+	{1000, "EUR", "USD", Round, ErrorRateNotFound.Error()},
+	{1000, "USA", "EUR", Round, ErrorInvalidISO.Error()},
+	{1000, "USD", "EUA", Round, ErrorInvalidISO.Error()},
+	{maxInt, "USD", "JPY", Round, ErrorArithmeticOverflow.Error()},
+}
+
+func TestConvert(t *testing.T) {
+	p := testRates(t)
+	for _, v := range TestConvertData {
+		result, rate, err := Convert(v.Amount, v.From, v.To, p, v.Round)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error("Expected:", v.Output, "Got:", err)
+			}
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		} else if rate.From != strings.ToUpper(v.From) || rate.To != strings.ToUpper(v.To) {
+			t.Error("Expected:", v.From, v.To, "Got:", rate)
+		}
+	}
+
+	_, rate, _ := Convert(1000, "USD", "EUR", p, Round)
+	if rate.Value.String() != "0.9215" || !rate.Timestamp.Equal(quoted) {
+		t.Error("Expected:", "0.9215 quoted", quoted, "Got:", rate)
+	}
+}
+
+func TestMoneyConvert(t *testing.T) {
+	p := testRates(t)
+	m, _ := NewMoney(1999, "USD")
+	result, rate, err := m.Convert("jpy", p, Ceil)
+	if err != nil || result.Display() != "¥3193" || rate.To != "JPY" {
+		t.Error("Expected:", "¥3193", "Got:", result.Display(), rate, err)
+	}
+	if _, _, err := m.Convert("EUR", NewStaticRateProvider(), Round); err != ErrorRateNotFound {
+		t.Error("Expected:", ErrorRateNotFound, "Got:", err)
+	}
+	m, _ = NewMoney(math.MaxInt64, "USD")
+	if _, _, err := m.Convert("JPY", p, Round); err != ErrorArithmeticOverflow {
+		t.Error("Expected:", ErrorArithmeticOverflow, "Got:", err)
+	}
+}
+
+func TestStaticRateProvider(t *testing.T) {
+	p := NewStaticRateProvider()
+	if err := p.SetRate("USD", "EUR", NewDecimal(0, 0), quoted); err != ErrorInvalidRate {
+		t.Error("Expected:", ErrorInvalidRate, "Got:", err)
+	}
+	if err := p.SetRate("USD", "EUA", NewDecimal(1, 0), quoted); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+	if err := p.SetRate("usd", "eur", NewDecimal(92, 2), quoted); err != nil {
+		t.Error(err)
+	}
+	rate, err := p.Rate("usd", "EUR")
+	if err != nil || rate.From != "USD" || rate.To != "EUR" || rate.Value.String() != "0.92" {
+		t.Error("Expected:", "USD/EUR 0.92", "Got:", rate, err)
+	}
+	if _, err := p.Rate("EUR", "USD"); err != ErrorRateNotFound {
+		t.Error("Expected:", ErrorRateNotFound, "Got:", err)
+	}
+}
+
+// rateFunc is an ExchangeRateProvider returning whatever rate the function gives
+type rateFunc func(from string, to string) (Rate, error)
+
+func (f rateFunc) Rate(from string, to string) (Rate, error) {
+	return f(from, to)
+}
+
+var TestConvertProviderRateData = []struct {
+	Rate   Rate
+	Output interface{}
+}{
+	{Rate{Value: NewDecimal(9215, 4)}, "EUR 9.22"},
+	{Rate{From: "usd", To: "eur", Value: NewDecimal(9215, 4)}, "EUR 9.22"},
+	{Rate{From: "USD", To: "GBP", Value: NewDecimal(9215, 4)}, ErrorInvalidRate.Error()},
+	{Rate{From: "EUR", To: "USD", Value: NewDecimal(9215, 4)}, ErrorInvalidRate.Error()},
+	{Rate{From: "USD", To: "EUA", Value: NewDecimal(9215, 4)}, ErrorInvalidRate.Error()},
+	{Rate{From: "USD", To: "EUR", Value: NewDecimal(0, 0)}, ErrorInvalidRate.Error()},
+}
+
+func TestConvertProviderRate(t *testing.T) {
+	m, _ := NewMoney(1000, "USD")
+	for _, v := range TestConvertProviderRateData {
+		rate := v.Rate
+		result, used, err := m.Convert("EUR", rateFunc(func(from string, to string) (Rate, error) { return rate, nil }), Round)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error("Expected:", v.Output, "Got:", err)
+			}
+		} else if result.DisplayWithAlpha() != v.Output || result.Alpha() != "EUR" || used.From != "USD" || used.To != "EUR" {
+			t.Error("Expected:", v.Output, "Got:", result.DisplayWithAlpha(), used)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package dough
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+type symbolMode string
+
+// Symbol modes choose what Format writes in place of the currency symbol
+const (
+	SymbolDefault symbolMode = "symbol"  // "$1.00"
+	SymbolAlpha   symbolMode = "alpha"   // "USD1.00", usually paired with SymbolSpace
+	SymbolNumeric symbolMode = "numeric" // "8401.00", usually paired with SymbolSpace
+	SymbolNone    symbolMode = "none"    // "1.00"
+)
+
+type signDisplay string
+
+// Sign displays choose when Format writes a sign
+const (
+	SignAuto       signDisplay = "auto"        // "-" for negative amounts only
+	SignAlways     signDisplay = "always"      // "+" for zero and positive amounts as well
+	SignExceptZero signDisplay = "except-zero" // "+" for positive amounts, nothing for zero
+	SignNever      signDisplay = "never"       // no sign at all
+)
+
+// CurrencyFraction - used for MinimumFractionDigits and MaximumFractionDigits to mean the ISO fraction
+const CurrencyFraction = -1
+
+// FormatOptions - struct containing the options Format uses to render an amount
+//
+// The zero value writes the symbol with no grouping, no decimal and no fraction digits,
+// so start from DefaultFormatOptions and change what is needed. Empty modes fall back
+// to SymbolDefault, SignAuto, NegativeAfterSymbol and Round. Fraction digits beyond the
+// maximum are rounded with Round, trailing zeros are dropped down to the minimum, and a
+// CurrencyFraction minimum never exceeds the maximum.
+type FormatOptions struct {
+	Symbol                symbolMode
+	SymbolSpace           bool
+	Grouping              bool
+	Decimal               bool
+	Sign                  signDisplay
+	Negative              negative
+	MinimumFractionDigits int
+	MaximumFractionDigits int
+	HideZeroFraction      bool
+	Round                 round
+}
+
+// DefaultFormatOptions : returns options for full currency formatting with the ISO symbol, grouping, decimal and fraction
+func DefaultFormatOptions() FormatOptions {
+	return FormatOptions{
+		Symbol:                SymbolDefault,
+		Grouping:              true,
+		Decimal:               true,
+		Sign:                  SignAuto,
+		Negative:              NegativeAfterSymbol,
+		MinimumFractionDigits: CurrencyFraction,
+		MaximumFractionDigits: CurrencyFraction,
+		Round:                 Round,
+	}
+}
+
+// displayOptions : returns the options the Display functions have always used for a symbol mode, which
+// never group currencies without minor unit digits and space an alpha code from the amount. A code written
+// after the amount is spaced before it, as in "0.00 AED", where the old SwapSymbolWithAlpha left "0.00AED ".
+func displayOptions(ISO Currency, symbol symbolMode, grouping bool) FormatOptions {
+	opts := DefaultFormatOptions()
+	opts.Symbol = symbol
+	opts.SymbolSpace = symbol == SymbolAlpha
+	opts.Grouping = grouping && ISO.Fraction > 0
+	return opts
+}
+
+// Format : returns a string formatted based upon the options... "num" being the amount, "alpha" being the ISO three digit alphabetic code.
+func Format(num int, alpha string, opts FormatOptions) (string, error) {
+	return FormatInt64(int64(num), alpha, opts)
+}
+
+// FormatInt64 : returns a string formatted based upon the options for an int64 amount
+func FormatInt64(num int64, alpha string, opts FormatOptions) (string, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return "", err
+	}
+	return formatInt64(num, ISO, opts), nil
+}
+
+// FormatBigInt : returns a string formatted based upon the options for a big.Int amount
+func FormatBigInt(num *big.Int, alpha string, opts FormatOptions) (string, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return "", err
+	}
+	return formatAmount(new(big.Int).Abs(num).String(), num.Sign() < 0, ISO, opts), nil
+}
+
+// formatInt64 : returns an int64 amount formatted based upon the ISO and options
+func formatInt64(num int64, ISO Currency, opts FormatOptions) string {
+	abs := uint64(num)
+	if num < 0 {
+		abs = -abs
+	}
+	return formatAmount(strconv.FormatUint(abs, 10), num < 0, ISO, opts)
+}
+
+// formatAmount : returns the unsigned minor unit digits of an amount formatted based upon the ISO and options
+func formatAmount(digits string, isNegative bool, ISO Currency, opts FormatOptions) string {
+	fraction := ISO.MinorDigits()
+	minFraction, maxFraction := opts.MinimumFractionDigits, opts.MaximumFractionDigits
+	if maxFraction < 0 {
+		maxFraction = fraction
+	}
+	if minFraction < 0 {
+		minFraction = fraction
+		if maxFraction < minFraction {
+			minFraction = maxFraction
+		}
+	}
+	if maxFraction < minFraction {
+		maxFraction = minFraction
+	}
+
+	// Round away the fraction digits that are not wanted, the sign matters for Floor and Ceil
+	if maxFraction < fraction {
+		coef, _ := new(big.Int).SetString(digits, 10)
+		if isNegative {
+			coef.Neg(coef)
+		}
+		rounded := newDecimal(coef, fraction).Round(maxFraction, opts.Round).int()
+		digits = new(big.Int).Abs(rounded).String()
+		isNegative = rounded.Sign() < 0
+		fraction = maxFraction
+	}
+	isZero := strings.Trim(digits, "0") == ""
+
+	intPart, fracPart := digits, ""
+	if fraction > 0 {
+		str := insertDecimalPoint(digits, fraction)
+		point := strings.Index(str, ".")
+		intPart, fracPart = str[:point], str[point+1:]
+	}
+	for len(fracPart) > minFraction && strings.HasSuffix(fracPart, "0") {
+		fracPart = fracPart[:len(fracPart)-1]
+	}
+	if len(fracPart) < minFraction {
+		fracPart += strings.Repeat("0", minFraction-len(fracPart))
+	}
+	if opts.HideZeroFraction && strings.Trim(fracPart, "0") == "" {
+		fracPart = ""
+	}
+
+	number := intPart
+	if opts.Grouping {
+		number = groupDigits(intPart, ISO.Grouping, ISO.SecondaryGrouping, ISO.Delimiter)
+	}
+	if fracPart != "" {
+		if opts.Decimal {
+			number += ISO.Decimal
+		}
+		number += fracPart
+	}
+
+	symbol := ISO.Symbol
+	switch opts.Symbol {
+	case SymbolAlpha:
+		symbol = ISO.Alpha
+	case SymbolNumeric:
+		symbol = ISO.Numeric
+	case SymbolNone:
+		symbol = ""
+	}
+	withSymbol := func(str string) string {
+		space := ""
+		if (opts.SymbolSpace || (symbol == ISO.Symbol && ISO.SymbolSpace)) && symbol != "" {
+			space = " "
+		}
+		if ISO.SymbolPositionFront != true {
+			return str + space + symbol
+		}
+		return symbol + space + str
+	}
+
+	switch {
+	case isNegative && !isZero && opts.Sign != SignNever:
+		switch opts.Negative {
+		case NegativeLeading:
+			return "-" + withSymbol(number)
+		case NegativeTrailing:
+			return withSymbol(number) + "-"
+		case NegativeParentheses:
+			return "(" + withSymbol(number) + ")"
+		case NegativeCredit:
+			return withSymbol(number) + " CR"
+		}
+		return withSymbol("-" + number)
+	case !isNegative && (opts.Sign == SignAlways || (opts.Sign == SignExceptZero && !isZero)):
+		switch opts.Negative {
+		case NegativeAfterSymbol, "":
+			return withSymbol("+" + number)
+		case NegativeTrailing:
+			return withSymbol(number) + "+"
+		}
+		return "+" + withSymbol(number)
+	}
+	return withSymbol(number)
+}
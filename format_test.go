@@ -0,0 +1,106 @@
+package dough
+
+import (
+	"math/big"
+	"testing"
+)
+
+// formatOptions returns DefaultFormatOptions with changes applied
+func formatOptions(change func(*FormatOptions)) FormatOptions {
+	opts := DefaultFormatOptions()
+	change(&opts)
+	return opts
+}
+
+var TestFormatData = []struct {
+	Num    int
+	Alpha  string
+	Opts   FormatOptions
+	Output string
+}{
+	{0, "USA", DefaultFormatOptions(), ErrorInvalidISO.Error()},
+	{123456, "USD", DefaultFormatOptions(), "$1,234.56"},
+	{-123456, "USD", DefaultFormatOptions(), "$-1,234.56"},
+	{100000, "JPY", DefaultFormatOptions(), "¥100,000"},
+	{123456, "AED", DefaultFormatOptions(), "1,234.56إ.د"},
+
+	// Symbol modes and spacing
+	{123456, "USD", formatOptions(func(o *FormatOptions) { o.Symbol = SymbolAlpha }), "USD1,234.56"},
+	{123456, "USD", formatOptions(func(o *FormatOptions) { o.Symbol = SymbolAlpha; o.SymbolSpace = true }), "USD 1,234.56"},
+	{123456, "USD", formatOptions(func(o *FormatOptions) { o.Symbol = SymbolNumeric; o.SymbolSpace = true }), "840 1,234.56"},
+	{123456, "USD", formatOptions(func(o *FormatOptions) { o.Symbol = SymbolNone; o.SymbolSpace = true }), "1,234.56"},
+	{123456, "USD", formatOptions(func(o *FormatOptions) { o.SymbolSpace = true }), "$ 1,234.56"},
+	{123456, "AED", formatOptions(func(o *FormatOptions) { o.Symbol = SymbolAlpha; o.SymbolSpace = true }), "1,234.56 AED"},
+
+	// Grouping and decimal
+	{123456, "USD", formatOptions(func(o *FormatOptions) { o.Grouping = false }), "$1234.56"},
+	{123456, "USD", formatOptions(func(o *FormatOptions) { o.Decimal = false }), "$1,23456"},
+	{123456, "USD", formatOptions(func(o *FormatOptions) { o.Symbol = SymbolAlpha; o.Grouping = false; o.Decimal = false }), "USD123456"},
+
+	// Signs
+	{100, "USD", formatOptions(func(o *FormatOptions) { o.Sign = SignAlways }), "$+1.00"},
+	{0, "USD", formatOptions(func(o *FormatOptions) { o.Sign = SignAlways }), "$+0.00"},
+	{0, "USD", formatOptions(func(o *FormatOptions) { o.Sign = SignExceptZero }), "$0.00"},
+	{100, "USD", formatOptions(func(o *FormatOptions) { o.Sign = SignExceptZero; o.Negative = NegativeLeading }), "+$1.00"},
+	{100, "USD", formatOptions(func(o *FormatOptions) { o.Sign = SignAlways; o.Negative = NegativeTrailing }), "$1.00+"},
+	{-100, "USD", formatOptions(func(o *FormatOptions) { o.Sign = SignAlways; o.Negative = NegativeTrailing }), "$1.00-"},
+	{-100, "USD", formatOptions(func(o *FormatOptions) { o.Sign = SignNever }), "$1.00"},
+	{-100, "USD", formatOptions(func(o *FormatOptions) { o.Negative = NegativeParentheses }), "($1.00)"},
+
+	// Fraction digits
+	{123400, "USD", formatOptions(func(o *FormatOptions) { o.HideZeroFraction = true }), "$1,234"},
+	{123450, "USD", formatOptions(func(o *FormatOptions) { o.HideZeroFraction = true }), "$1,234.50"},
+	{123450, "USD", formatOptions(func(o *FormatOptions) { o.MinimumFractionDigits = 0 }), "$1,234.5"},
+	{123400, "USD", formatOptions(func(o *FormatOptions) { o.MinimumFractionDigits = 0 }), "$1,234"},
+	{123456, "USD", formatOptions(func(o *FormatOptions) { o.MinimumFractionDigits = 4; o.MaximumFractionDigits = 4 }), "$1,234.5600"},
+	{123456, "USD", formatOptions(func(o *FormatOptions) { o.MaximumFractionDigits = 1 }), "$1,234.6"},
+	{123456, "USD", formatOptions(func(o *FormatOptions) { o.MaximumFractionDigits = 1; o.Round = Floor }), "$1,234.5"},
+	{-123456, "USD", formatOptions(func(o *FormatOptions) { o.MaximumFractionDigits = 0; o.Round = Floor }), "$-1,235"},
+	{-123456, "USD", formatOptions(func(o *FormatOptions) { o.MaximumFractionDigits = 0; o.Round = Ceil }), "$-1,234"},
+	{-4, "USD", formatOptions(func(o *FormatOptions) { o.MaximumFractionDigits = 1 }), "$0.0"},
+	{150, "USD", formatOptions(func(o *FormatOptions) { o.MaximumFractionDigits = 0; o.Round = "" }), "$2"},
+	{1000, "JPY", formatOptions(func(o *FormatOptions) { o.MinimumFractionDigits = 2 }), "¥1,000.00"},
+
+	// Zero value options
+	{123456, "USD", FormatOptions{}, "$1235"},
+}
+
+func TestFormat(t *testing.T) {
+	for _, v := range TestFormatData {
+		result, err := Format(v.Num, v.Alpha, v.Opts)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+func TestFormatWideAndMoney(t *testing.T) {
+	opts := formatOptions(func(o *FormatOptions) { o.Symbol = SymbolAlpha; o.SymbolSpace = true; o.Negative = NegativeLeading })
+
+	result, err := FormatInt64(-9223372036854775808, "USD", opts)
+	if err != nil || result != "-USD 92,233,720,368,547,758.08" {
+		t.Error(result, err)
+	}
+	result, err = FormatInt64(12345678900, "INR", DefaultFormatOptions())
+	if err != nil || result != "₹12,34,56,789.00" {
+		t.Error(result, err)
+	}
+
+	num, _ := new(big.Int).SetString("-123456789012345678901", 10)
+	result, err = FormatBigInt(num, "USD", opts)
+	if err != nil || result != "-USD 1,234,567,890,123,456,789.01" {
+		t.Error(result, err)
+	}
+	if _, err := FormatBigInt(num, "USA", opts); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+
+	m, _ := NewMoney(-100, "USD")
+	if result := m.Format(opts); result != "-USD 1.00" {
+		t.Error(result)
+	}
+}
@@ -1,11 +1,19 @@
 package dough
 
 import (
-	"fmt"
 	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
 	"strings"
 )
 
+// The platform int range, which FloatToInt saturates at
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
 type round string
 
 const (
@@ -15,62 +23,287 @@ const (
 	Bankers round = "bankers"
 )
 
-// GetISOFromNumeric : returns an ISO currency struct or an error if the ISO is not found
-func GetISOFromNumeric(num string) (Currency, error) {
-	alpha, err := GetAlphaFromISONumeric(num)
-	if err != nil {
-		return Currency{}, ErrorInvalidISO
+type negative string
+
+// Negative styles control how FormatCurrencyNegative writes amounts below zero
+const (
+	NegativeAfterSymbol negative = "after-symbol" // "$-1.00", the FormatCurrency default
+	NegativeLeading     negative = "leading"      // "-$1.00"
+	NegativeTrailing    negative = "trailing"     // "$1.00-"
+	NegativeParentheses negative = "parentheses"  // "($1.00)"
+	NegativeCredit      negative = "credit"       // "$1.00 CR"
+)
+
+// divRound : returns num / den rounded to an integer based upon the round mode
+func divRound(num *big.Int, den *big.Int, mode round) *big.Int {
+	if num.IsInt64() && den.IsInt64() && num.Int64() != math.MinInt64 && den.Int64() != math.MinInt64 {
+		return big.NewInt(divRoundInt64(num.Int64(), den.Int64(), mode))
 	}
-	currency, err := GetISOFromAlpha(alpha)
-	if err != nil {
-		return Currency{}, ErrorInvalidISO
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// QuoRem truncates toward zero, so any adjustment moves q one step away from zero
+	// in the direction of the exact quotient
+	sign := int64(num.Sign() * den.Sign())
+	twice := new(big.Int).Abs(r)
+	half := twice.Lsh(twice, 1).Cmp(new(big.Int).Abs(den))
+
+	switch mode {
+	case Floor:
+		if sign < 0 {
+			q.Sub(q, big.NewInt(1))
+		}
+	case Ceil:
+		if sign > 0 {
+			q.Add(q, big.NewInt(1))
+		}
+	case Bankers:
+		if half > 0 || (half == 0 && q.Bit(0) == 1) {
+			q.Add(q, big.NewInt(sign))
+		}
+	default:
+		if half >= 0 {
+			q.Add(q, big.NewInt(sign))
+		}
 	}
-	return currency, nil
+	return q
 }
 
-// GetISOFromAlpha : returns an ISO currency struct or an error if the ISO is not found
-func GetISOFromAlpha(alpha string) (Currency, error) {
-	alpha = strings.ToUpper(alpha)
-	for key := range CurrencyList {
-		if key == alpha {
-			return CurrencyList[key], nil
+// divRoundInt64 : returns num / den rounded based upon the round mode with int64 arithmetic, so callers with
+// operands in the int64 range avoid big.Int, num and den not being math.MinInt64
+func divRoundInt64(num int64, den int64, mode round) int64 {
+	q, r := num/den, num%den
+	if r == 0 {
+		return q
+	}
+
+	var sign int64 = 1
+	if (num < 0) != (den < 0) {
+		sign = -1
+	}
+	if r < 0 {
+		r = -r
+	}
+	if den < 0 {
+		den = -den
+	}
+
+	// compare 2r with den without overflowing
+	half := 0
+	if r > den-r {
+		half = 1
+	} else if r < den-r {
+		half = -1
+	}
+
+	switch mode {
+	case Floor:
+		if sign < 0 {
+			q--
+		}
+	case Ceil:
+		if sign > 0 {
+			q++
+		}
+	case Bankers:
+		if half > 0 || (half == 0 && q%2 != 0) {
+			q += sign
+		}
+	default:
+		if half >= 0 {
+			q += sign
 		}
 	}
-	return Currency{}, ErrorInvalidISO
+	return q
 }
 
-// GetISOCodeFromNumeric : returns a formatted ISO numeric code or an error if the ISO is not found
-func GetISOCodeFromNumeric(num string) (string, error) {
-	for _, value := range CurrencyList {
-		if value.Numeric == num {
-			return value.Numeric, nil
+// int64Powers - the powers of ten that fit in an int64
+var int64Powers = func() []int64 {
+	powers := []int64{1}
+	for len(powers) < 19 {
+		powers = append(powers, powers[len(powers)-1]*10)
+	}
+	return powers
+}()
+
+// mulInt64 : returns a * b, or false when the product overflows int64
+func mulInt64(a int64, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	if c/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return c, true
+}
+
+// roundInt64 : returns coef * 10^-scale as a count of 10^-fraction units rounded based upon the round mode,
+// or false when it does not fit in int64
+func roundInt64(coef int64, scale int, fraction int, mode round) (int64, bool) {
+	if fraction >= scale {
+		if fraction-scale >= len(int64Powers) {
+			return 0, coef == 0
 		}
+		return mulInt64(coef, int64Powers[fraction-scale])
+	}
+	if scale-fraction >= len(int64Powers) || coef == math.MinInt64 {
+		return 0, false
 	}
-	return "", ErrorInvalidISO
+	return divRoundInt64(coef, int64Powers[scale-fraction], mode), true
 }
 
-// GetAlphaFromISONumeric : returns a formatted ISO alpha code from the ISO numeric counterpart
-func GetAlphaFromISONumeric(num string) (string, error) {
-	for _, value := range CurrencyList {
-		if value.Numeric == num {
-			return value.Alpha, nil
+// mulRoundInt64 : returns a * b / 10^k rounded based upon the round mode, or false when the result does not fit
+// in int64 or k is above 18
+//
+// The product is taken in 128 bits, so amounts with many float digits times a percentage do not overflow.
+func mulRoundInt64(a int64, b int64, k int, mode round) (int64, bool) {
+	if k <= 0 {
+		product, ok := mulInt64(a, b)
+		if !ok {
+			return 0, false
+		}
+		return roundInt64(product, 0, -k, mode)
+	}
+	if k >= len(int64Powers) {
+		return 0, false
+	}
+
+	isNegative := (a < 0) != (b < 0)
+	ua, ub := uint64(a), uint64(b)
+	if a < 0 {
+		ua = -ua
+	}
+	if b < 0 {
+		ub = -ub
+	}
+	hi, lo := bits.Mul64(ua, ub)
+	den := uint64(int64Powers[k])
+	if hi >= den {
+		return 0, false
+	}
+	q, r := bits.Div64(hi, lo, den)
+	if r != 0 {
+		switch {
+		case mode == Floor:
+			if isNegative {
+				q++
+			}
+		case mode == Ceil:
+			if !isNegative {
+				q++
+			}
+		case mode == Bankers:
+			if r > den-r || (r == den-r && q%2 != 0) {
+				q++
+			}
+		case r >= den-r:
+			q++
 		}
 	}
-	return "", ErrorInvalidISO
+	if q > math.MaxInt64 {
+		return 0, false
+	}
+	if isNegative {
+		return -int64(q), true
+	}
+	return int64(q), true
 }
 
-// ConvertToStringWithDecimal : returns the uint as a stringified float
-func ConvertToStringWithDecimal(num int, fraction int) string {
-	return fmt.Sprintf("%.*f", fraction, float64(float64(num)/math.Pow10(fraction)))
+// floatInt64 : returns the shortest decimal form of a float as an int64 coefficient and scale without
+// allocating, or false when it does not fit
+func floatInt64(amt float64) (int64, int, bool) {
+	if math.IsNaN(amt) || math.IsInf(amt, 0) {
+		return 0, 0, false
+	}
+	var buf [32]byte
+	str := strconv.AppendFloat(buf[:0], amt, 'f', -1, 64)
+	isNegative := str[0] == '-'
+	if isNegative {
+		str = str[1:]
+	}
+
+	var coef int64
+	scale, point := 0, false
+	for _, c := range str {
+		if c == '.' {
+			point = true
+			continue
+		}
+		if coef > (math.MaxInt64-int64(c-'0'))/10 {
+			return 0, 0, false
+		}
+		coef = coef*10 + int64(c-'0')
+		if point {
+			scale++
+		}
+	}
+	if isNegative {
+		coef = -coef
+	}
+	return coef, scale, true
 }
 
-// reverseString : returns a reversed string for delimiter formatting
-func reverseString(str string) string {
-	var output string
-	for key := len(str) - 1; key >= 0; key-- {
-		output += string(str[key])
+// floatMinorUnits : returns a float in minor units rounded to fraction digits with int64 arithmetic, or false
+// when it does not fit
+func floatMinorUnits(amt float64, fraction int) (int64, bool) {
+	coef, scale, ok := floatInt64(amt)
+	if !ok {
+		return 0, false
 	}
-	return output
+	return roundInt64(coef, scale, wholeScale(fraction), Round)
+}
+
+// percentageInt64 : returns percentage percent of coef * 10^-scale rounded to fraction digits as a float with
+// int64 arithmetic, or false when an operand or the result does not fit
+func percentageInt64(coef int64, scale int, percentage float64, fraction int, mode round) (float64, bool) {
+	pct, pctScale, ok := floatInt64(percentage)
+	if !ok {
+		return 0, false
+	}
+	fraction = wholeScale(fraction)
+	result, ok := mulRoundInt64(coef, pct, scale+pctScale+2-fraction, mode)
+
+	// Both operands are exact floats here so the division is correctly rounded, as in Decimal.Float64
+	if !ok || fraction > 22 || result >= 1<<53 || result <= -1<<53 {
+		return 0, false
+	}
+	return float64(result) / math.Pow10(fraction), true
+}
+
+// GetISOFromNumeric : returns an ISO currency struct or an error if the ISO is not found
+func GetISOFromNumeric(num string) (Currency, error) {
+	return DefaultRegistry.LookupNumeric(num)
+}
+
+// GetISOFromAlpha : returns an ISO currency struct or an error if the ISO is not found
+func GetISOFromAlpha(alpha string) (Currency, error) {
+	return DefaultRegistry.Lookup(alpha)
+}
+
+// GetISOCodeFromNumeric : returns a formatted ISO numeric code or an error if the ISO is not found
+func GetISOCodeFromNumeric(num string) (string, error) {
+	ISO, err := DefaultRegistry.LookupNumeric(num)
+	if err != nil {
+		return "", err
+	}
+	return ISO.Numeric, nil
+}
+
+// GetAlphaFromISONumeric : returns a formatted ISO alpha code from the ISO numeric counterpart
+func GetAlphaFromISONumeric(num string) (string, error) {
+	ISO, err := DefaultRegistry.LookupNumeric(num)
+	if err != nil {
+		return "", err
+	}
+	return ISO.Alpha, nil
+}
+
+// ConvertToStringWithDecimal : returns the uint as a stringified float, a negative fraction such as FractionNA having no decimal
+func ConvertToStringWithDecimal(num int, fraction int) string {
+	return NewDecimal(int64(num), wholeScale(fraction)).String()
 }
 
 // InsertDelimiter : returns a new string with delimiter formatting
@@ -86,6 +319,33 @@ func InsertDelimiter(str string, group int, del string) string {
 	return output
 }
 
+// groupDigits : returns the integer digits with the delimiter inserted after the first group digits from the right
+// and then every secondary digits, so a group of 3 and secondary of 2 renders lakh/crore "12,34,567"
+func groupDigits(str string, group int, secondary int, del string) string {
+	if group <= 0 || len(str) <= group {
+		return str
+	}
+	if secondary <= 0 {
+		secondary = group
+	}
+
+	// Work forwards from the leading partial group so multi-byte delimiters stay intact
+	var output strings.Builder
+	rest := len(str) - group
+	first := rest % secondary
+	if first == 0 {
+		first = secondary
+	}
+	output.WriteString(str[:first])
+	for key := first; key < rest; key += secondary {
+		output.WriteString(del)
+		output.WriteString(str[key : key+secondary])
+	}
+	output.WriteString(del)
+	output.WriteString(str[rest:])
+	return output.String()
+}
+
 // SwapSymbolWithAlpha : returns a string with the ISO alpha code instead of symbol
 func SwapSymbolWithAlpha(str string, sym string, alpha string) string {
 	return strings.Replace(str, sym, alpha+" ", -1)
@@ -116,91 +376,102 @@ func IsNegative(num int) bool {
 
 // FormatCurrency : returns basic currency formatting
 func FormatCurrency(num int, ISO Currency) string {
-	isNegative := IsNegative(num)
-	isNegativeText := ""
-	if isNegative {
-		isNegativeText = "-"
-	}
-	num = int(math.Abs(float64(num)))
+	return FormatCurrencyInt64(int64(num), ISO)
+}
 
-	// to catch frational split panic
-	if ISO.Fraction == 0 {
-		if ISO.SymbolPositionFront != true {
-			return fmt.Sprintf("%s%d%s", isNegativeText, num, ISO.Symbol)
-		}
-		return fmt.Sprintf("%s%s%d", ISO.Symbol, isNegativeText, num)
-	}
-	str := ConvertToStringWithDecimal(num, ISO.Fraction)
-	strSplit := strings.Split(str, ".")
-	strSplit[0] = reverseString(strSplit[0])
-	strSplit[0] = InsertDelimiter(strSplit[0], ISO.Grouping, ISO.Delimiter)
-	strSplit[0] = reverseString(strSplit[0])
-	if ISO.SymbolPositionFront != true {
-		return isNegativeText + strSplit[0] + ISO.Decimal + strSplit[1] + ISO.Symbol
-	}
-	return ISO.Symbol + isNegativeText + strSplit[0] + ISO.Decimal + strSplit[1]
+// FormatCurrencyNegative : returns basic currency formatting with negative amounts written in the given style
+func FormatCurrencyNegative(num int, ISO Currency, style negative) string {
+	opts := displayOptions(ISO, SymbolDefault, true)
+	opts.Negative = style
+	return formatInt64(int64(num), ISO, opts)
+}
+
+// FormatCurrencyInt64 : returns basic currency formatting for an int64 amount
+func FormatCurrencyInt64(num int64, ISO Currency) string {
+	return formatInt64(num, ISO, displayOptions(ISO, SymbolDefault, true))
+}
+
+// FormatCurrencyBigInt : returns basic currency formatting for a big.Int amount
+func FormatCurrencyBigInt(num *big.Int, ISO Currency) string {
+	return formatAmount(new(big.Int).Abs(num).String(), num.Sign() < 0, ISO, displayOptions(ISO, SymbolDefault, true))
 }
 
 // FloatToInt will take in a float and based upon fraction will output the int version
+//
+// Amounts past the int range saturate at the largest or smallest int, so use FloatToInt64 to get
+// ErrorAmountOverflow instead, as for 10.0 with a fraction of 18.
 func FloatToInt(amt float64, fraction int) int {
-	return int(math.Round(((amt * 100) * (float64(math.Pow10(fraction)) / 10000)) * 100))
+	if amount, ok := floatMinorUnits(amt, fraction); ok && int64(int(amount)) == amount {
+		return int(amount)
+	}
+	dec, err := DecimalFromFloat(amt)
+	if err != nil {
+		return 0
+	}
+	amount := dec.MinorUnits(fraction, Round)
+	switch {
+	case fitsInt(amount):
+		return int(amount.Int64())
+	case amount.Sign() > 0:
+		return maxInt
+	default:
+		return minInt
+	}
+}
+
+// FloatToInt64 : returns a float in minor units based upon fraction, or ErrorAmountOverflow past the int64 range
+func FloatToInt64(amt float64, fraction int) (int64, error) {
+	if amount, ok := floatMinorUnits(amt, fraction); ok {
+		return amount, nil
+	}
+	dec, err := DecimalFromFloat(amt)
+	if err != nil {
+		return 0, err
+	}
+	amount := dec.MinorUnits(fraction, Round)
+	if !amount.IsInt64() {
+		return 0, ErrorAmountOverflow
+	}
+	return amount.Int64(), nil
 }
 
 // IntToFloat will take in a int and based upon fraction will output the float version
 func IntToFloat(amt int, fraction int) float64 {
-	return float64(float64(amt) / math.Pow10(fraction))
+	return NewDecimal(int64(amt), wholeScale(fraction)).Float64()
 }
 
 // PercentageFromInt will give you a percentage to the exact precision that you want based on fraction
 func PercentageFromInt(amt int, percentage float64, fraction int, round round) float64 {
-	// Calculate percentage.
-	val := float64(amt) * percentage
-	val = val / 100
-
-	// Remove potential rounding errors by moving decimal
-	// two places past desired fraction and truncating.
-	val = math.Trunc(val*math.Pow10(fraction+2)) / math.Pow10(fraction+2)
-
-	// Handle rounding.
-	switch round {
-	case Round:
-		val = math.Round(val*math.Pow10(fraction)) / math.Pow10(fraction)
-	case Floor:
-		val = math.Floor(val*math.Pow10(fraction)) / math.Pow10(fraction)
-	case Ceil:
-		val = math.Ceil(val*math.Pow10(fraction)) / math.Pow10(fraction)
-	case Bankers:
-		val = math.RoundToEven(val*math.Pow10(fraction)) / math.Pow10(fraction)
-	default:
-		val = math.Round(val*math.Pow10(fraction)) / math.Pow10(fraction)
+	if result, ok := percentageInt64(int64(amt), 0, percentage, fraction, round); ok {
+		return result
 	}
-
-	return val
+	return PercentageFromIntDecimal(amt, percentage, fraction, round).Float64()
 }
 
 // PercentageFromFloat will give you a percentage to the exact precision that you want based on fraction
 func PercentageFromFloat(amt float64, percentage float64, fraction int, round round) float64 {
-	// Calculate percentage.
-	val := amt * percentage
-	val = val / 100
-
-	// Remove potential rounding errors by moving decimal
-	// two places past desired fraction and truncating.
-	val = math.Trunc(val*math.Pow10(fraction+2)) / math.Pow10(fraction+2)
-
-	// Handle rounding.
-	switch round {
-	case Round:
-		val = math.Round(val*math.Pow10(fraction)) / math.Pow10(fraction)
-	case Floor:
-		val = math.Floor(val*math.Pow10(fraction)) / math.Pow10(fraction)
-	case Ceil:
-		val = math.Ceil(val*math.Pow10(fraction)) / math.Pow10(fraction)
-	case Bankers:
-		val = math.RoundToEven(val*math.Pow10(fraction)) / math.Pow10(fraction)
-	default:
-		val = math.Round(val*math.Pow10(fraction)) / math.Pow10(fraction)
+	if coef, scale, ok := floatInt64(amt); ok {
+		if result, ok := percentageInt64(coef, scale, percentage, fraction, round); ok {
+			return result
+		}
 	}
+	return PercentageFromFloatDecimal(amt, percentage, fraction, round).Float64()
+}
+
+// PercentageFromIntDecimal will give you a percentage as an exact Decimal rounded to fraction
+func PercentageFromIntDecimal(amt int, percentage float64, fraction int, round round) Decimal {
+	pct, _ := DecimalFromFloat(percentage)
+	return PercentageFromDecimal(NewDecimal(int64(amt), 0), pct, fraction, round)
+}
+
+// PercentageFromFloatDecimal will give you a percentage as an exact Decimal rounded to fraction
+func PercentageFromFloatDecimal(amt float64, percentage float64, fraction int, round round) Decimal {
+	dec, _ := DecimalFromFloat(amt)
+	pct, _ := DecimalFromFloat(percentage)
+	return PercentageFromDecimal(dec, pct, fraction, round)
+}
 
-	return val
+// PercentageFromDecimal will give you a percentage of a Decimal rounded to fraction without any float conversion
+func PercentageFromDecimal(amt Decimal, percentage Decimal, fraction int, round round) Decimal {
+	return amt.Mul(percentage).Shift(-2).Round(fraction, round)
 }
@@ -2,6 +2,7 @@ package dough
 
 import (
 	"math"
+	"math/big"
 	"math/rand"
 	"strconv"
 	"strings"
@@ -138,6 +139,7 @@ var TestConvertToStringWithDecimalData = []struct {
 	{int(1000000), 2, "10000.00"},
 	{int(10000000), 2, "100000.00"},
 	{int(100000000), 2, "1000000.00"},
+	{int(100), FractionNA, "100"},
 }
 
 func TestConvertToStringWithDecimal(t *testing.T) {
@@ -149,29 +151,6 @@ func TestConvertToStringWithDecimal(t *testing.T) {
 	}
 }
 
-var TestreverseStringData = []struct {
-	Input  string
-	Output string
-}{
-	{"0", "0"},
-	{"1", "1"},
-	{"01", "10"},
-	{"001", "100"},
-	{"000,1", "1,000"},
-	{"000,01", "10,000"},
-	{"000,001", "100,000"},
-	{"000,000,1", "1,000,000"},
-}
-
-func TestReverseString(t *testing.T) {
-	for _, v := range TestreverseStringData {
-		result := reverseString(v.Input)
-		if result != v.Output {
-			t.Error(result)
-		}
-	}
-}
-
 var TestInsertDelimiterData = []struct {
 	Str    string
 	Group  int
@@ -498,6 +477,8 @@ var intPercentageData = []struct {
 
 	{3, 50, 0, Bankers, 2},
 	{5, 50, 0, Bankers, 2},
+	{100, 10, FractionNA, Round, 10},
+	{105, 10, FractionNA, Round, 11},
 }
 
 func TestGetPercentageFromInt(t *testing.T) {
@@ -705,3 +686,281 @@ func TestRoundingError(t *testing.T) {
 		}
 	}
 }
+
+var TestDivRoundData = []struct {
+	Num    int64
+	Den    int64
+	Round  round
+	Output int64
+}{
+	{10, 4, Round, 3},
+	{-10, 4, Round, -3},
+	{9, 4, Round, 2},
+	{10, 4, Floor, 2},
+	{-10, 4, Floor, -3},
+	{10, -4, Floor, -3},
+	{10, 4, Ceil, 3},
+	{-10, 4, Ceil, -2},
+	{10, 4, Bankers, 2},
+	{14, 4, Bankers, 4},
+	{-10, 4, Bankers, -2},
+	{-14, 4, Bankers, -4},
+	{11, 4, Bankers, 3},
+	{12, 4, Bankers, 3},
+	{12, 4, "unknown", 3},
+	{10, 4, "unknown", 3},
+}
+
+func TestDivRound(t *testing.T) {
+	for _, v := range TestDivRoundData {
+		result := divRound(big.NewInt(v.Num), big.NewInt(v.Den), v.Round)
+		if result.Int64() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result, "For:", v.Num, "/", v.Den, v.Round)
+		}
+	}
+}
+
+func BenchmarkDivRound(b *testing.B) {
+	num, den := big.NewInt(5091459), big.NewInt(1000)
+	for n := 0; n < b.N; n++ {
+		divRound(num, den, Bankers)
+	}
+}
+
+var TestMulRoundInt64Data = []struct {
+	A, B int64
+	K    int
+}{
+	{89357, 854, 3},
+	{-89357, 854, 3},
+	{15, 5, 1},
+	{25, 5, 1},
+	{-25, 5, 1},
+	{123456789012345678, 567891, 18},
+	{-123456789012345678, 567891, 18},
+	{math.MaxInt64, 3, 1},
+	{math.MinInt64, 1, 1},
+	{7, 3, 0},
+	{7, 3, -2},
+}
+
+func TestMulRoundInt64(t *testing.T) {
+	for _, v := range TestMulRoundInt64Data {
+		for _, mode := range []round{Round, Floor, Ceil, Bankers} {
+			expected := divRound(new(big.Int).Mul(big.NewInt(v.A), big.NewInt(v.B)), pow10(wholeScale(v.K)), mode)
+			if v.K < 0 {
+				expected.Mul(expected, pow10(-v.K))
+			}
+			result, ok := mulRoundInt64(v.A, v.B, v.K, mode)
+			if ok != expected.IsInt64() || (ok && result != expected.Int64()) {
+				t.Error("Expected:", expected, "Got:", result, ok, "For:", v.A, "*", v.B, "/ 10 ^", v.K, mode)
+			}
+		}
+	}
+}
+
+// TestInt64PathAllocs guards the int64 paths that keep the float helpers free of big.Int
+func TestInt64PathAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		FloatToInt(1234567.99, 2)
+		PercentageFromInt(89357, 85.4, 2, Round)
+		PercentageFromFloat(1234.5600000000123, 56.7891, 3, Bankers)
+	})
+	if allocs != 0 {
+		t.Error("Expected:", 0, "Got:", allocs)
+	}
+}
+
+func BenchmarkPercentageFromInt(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		PercentageFromInt(89357, 85.4, 2, Round)
+	}
+}
+
+func BenchmarkPercentageFromFloat(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		PercentageFromFloat(893.57, 85.4, 2, Round)
+	}
+}
+
+var TestConvertToStringWithDecimalLargeData = []struct {
+	Num    int64
+	Exp    int
+	Output string
+}{
+	{math.MaxInt64, 2, "92233720368547758.07"},
+	{math.MinInt64, 2, "-92233720368547758.08"},
+	{math.MaxInt64, 4, "922337203685477.5807"},
+	{123456789012345678, 3, "123456789012345.678"},
+	{-5, 3, "-0.005"},
+}
+
+func TestConvertToStringWithDecimalLarge(t *testing.T) {
+	for _, v := range TestConvertToStringWithDecimalLargeData {
+		// Amounts past the range of int are only tested where int is 64 bits
+		if int64(int(v.Num)) != v.Num {
+			continue
+		}
+		result := ConvertToStringWithDecimal(int(v.Num), v.Exp)
+		if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+var TestFloatToIntPrecisionData = []struct {
+	Amt      float64
+	Fraction int
+	Output   int64
+}{
+	{1.005, 2, 101},
+	{1.0005, 3, 1001},
+	{0.0001, 4, 1},
+	{12345678.9999, 4, 123456789999},
+	{9007199254740.99, 2, 900719925474099},
+}
+
+func TestFloatToIntPrecision(t *testing.T) {
+	for _, v := range TestFloatToIntPrecisionData {
+		// Amounts past the range of int are only tested where int is 64 bits
+		if int64(int(v.Output)) != v.Output {
+			continue
+		}
+		result := FloatToInt(v.Amt, v.Fraction)
+		if int64(result) != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+var TestFloatToInt64Data = []struct {
+	Amt      float64
+	Fraction int
+	Output   interface{}
+}{
+	{9.99, 2, int64(999)},
+	{-9.99, 2, int64(-999)},
+	{9.2, 18, int64(9200000000000000000)},
+	{0.000000000000000001, 18, int64(1)},
+	{10.0, 18, ErrorAmountOverflow.Error()},
+	{-10.0, 18, ErrorAmountOverflow.Error()},
+	{1e30, 2, ErrorAmountOverflow.Error()},
+	{math.NaN(), 2, ErrorInvalidFloat.Error()},
+}
+
+func TestFloatToInt64(t *testing.T) {
+	for _, v := range TestFloatToInt64Data {
+		result, err := FloatToInt64(v.Amt, v.Fraction)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error("Expected:", v.Output, "Got:", err)
+			}
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+func TestFloatToIntOverflow(t *testing.T) {
+	if result := FloatToInt(10.0, 18); result != maxInt {
+		t.Error("Expected:", maxInt, "Got:", result)
+	}
+	if result := FloatToInt(1e30, 2); result != maxInt {
+		t.Error("Expected:", maxInt, "Got:", result)
+	}
+	if result := FloatToInt(-1e30, 2); result != minInt {
+		t.Error("Expected:", minInt, "Got:", result)
+	}
+}
+
+var decimalPercentageData = []struct {
+	amt      string
+	pct      string
+	fraction int
+	round    round
+	result   string
+}{
+	{"898", "56.7", 3, Round, "509.166"},
+	{"92233720368547758.07", "100", 2, Round, "92233720368547758.07"},
+	{"92233720368547758.07", "12.5", 2, Bankers, "11529215046068469.76"},
+	{"1.525", "100", 2, Bankers, "1.52"},
+	{"0.0001", "50", 4, Ceil, "0.0001"},
+	{"0.0001", "50", 4, Floor, "0.0000"},
+}
+
+func TestPercentageFromDecimal(t *testing.T) {
+	for _, v := range decimalPercentageData {
+		amt, _ := ParseDecimal(v.amt)
+		pct, _ := ParseDecimal(v.pct)
+		result := PercentageFromDecimal(amt, pct, v.fraction, v.round)
+		if result.String() != v.result {
+			t.Error("Expected: ", v.result, "Got: ", result.String())
+		}
+	}
+	if result := PercentageFromIntDecimal(898, 56.7, 2, Round).String(); result != "509.17" {
+		t.Error(result)
+	}
+	if result := PercentageFromFloatDecimal(11.11, 13, 4, Round).String(); result != "1.4443" {
+		t.Error(result)
+	}
+}
+
+func TestFormatCurrencyWide(t *testing.T) {
+	ISO := Currency{Unit: "US Dollar", Alpha: "USD", Numeric: "840", Symbol: "$", Fraction: 2, Decimal: ".", Grouping: 3, Delimiter: ",", SymbolPositionFront: true}
+	if result := FormatCurrencyInt64(math.MinInt64, ISO); result != "$-92,233,720,368,547,758.08" {
+		t.Error(result)
+	}
+	num, _ := new(big.Int).SetString("-123456789012345678901", 10)
+	if result := FormatCurrencyBigInt(num, ISO); result != "$-1,234,567,890,123,456,789.01" {
+		t.Error(result)
+	}
+}
+
+var TestGroupDigitsData = []struct {
+	Str       string
+	Group     int
+	Secondary int
+	Del       string
+	Output    string
+}{
+	{"1", 3, 0, ",", "1"},
+	{"123", 3, 0, ",", "123"},
+	{"1234", 3, 0, ",", "1,234"},
+	{"1234567", 3, 0, ",", "1,234,567"},
+	{"1234567", 0, 0, ",", "1234567"},
+	{"1234", 3, 2, ",", "1,234"},
+	{"12345", 3, 2, ",", "12,345"},
+	{"123456", 3, 2, ",", "1,23,456"},
+	{"1234567", 3, 2, ",", "12,34,567"},
+	{"123456789", 3, 2, ",", "12,34,56,789"},
+	{"1234567", 3, 0, " ", "1 234 567"},
+	{"12345678", 4, 0, ",", "1234,5678"},
+}
+
+func TestGroupDigits(t *testing.T) {
+	for _, v := range TestGroupDigitsData {
+		result := groupDigits(v.Str, v.Group, v.Secondary, v.Del)
+		if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+func TestNoMinorUnitFraction(t *testing.T) {
+	ISO, _ := GetISOFromAlpha("XAU")
+	if result := FloatToInt(100, ISO.Fraction); result != 100 {
+		t.Error("Expected:", 100, "Got:", result)
+	}
+	if result := FloatToInt(2.5, ISO.Fraction); result != 3 {
+		t.Error("Expected:", 3, "Got:", result)
+	}
+	if result := IntToFloat(100, ISO.Fraction); result != 100 {
+		t.Error("Expected:", 100, "Got:", result)
+	}
+	if result := PercentageFromDecimal(NewDecimal(100, 0), NewDecimal(125, 1), ISO.Fraction, Round); result.String() != "13" {
+		t.Error("Expected:", "13", "Got:", result)
+	}
+	if result, _ := NewDecimal(10, 0).Quo(NewDecimal(3, 0), FractionNA, Round); result.String() != "3" {
+		t.Error("Expected:", "3", "Got:", result)
+	}
+}
@@ -0,0 +1,78 @@
+package dough
+
+import (
+	"strings"
+	"time"
+)
+
+// HistoricCurrency - a withdrawn currency with the dates it was in use and the alpha code that replaced it
+type HistoricCurrency struct {
+	Currency
+	Introduced time.Time
+	Withdrawn  time.Time
+	ReplacedBy string
+}
+
+// ActiveOn : returns whether the currency was in use on a date
+func (h HistoricCurrency) ActiveOn(date time.Time) bool {
+	return !date.Before(h.Introduced) && date.Before(h.Withdrawn)
+}
+
+// GetHistoricCurrency : returns a withdrawn currency or an error if the alpha code is not in HistoricCurrencyList
+func GetHistoricCurrency(alpha string) (HistoricCurrency, error) {
+	h, ok := HistoricCurrencyList[strings.ToUpper(alpha)]
+	if !ok {
+		return HistoricCurrency{}, ErrorInvalidISO
+	}
+	return h, nil
+}
+
+// GetISOFromAlphaAsOf : returns the currency an alpha code named on a date, including withdrawn currencies
+//
+// Currencies in DefaultRegistry are in use from the date in introducedList, or on every date when they
+// have none, unless HistoricCurrencyList withdraws them. A code that exists but was not in use on the
+// date returns ErrorCurrencyInactive.
+func GetISOFromAlphaAsOf(alpha string, date time.Time) (Currency, error) {
+	if h, err := GetHistoricCurrency(alpha); err == nil {
+		if !h.ActiveOn(date) {
+			return Currency{}, ErrorCurrencyInactive
+		}
+		return h.Currency, nil
+	}
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return Currency{}, err
+	}
+	if introduced, ok := introducedList[ISO.Alpha]; ok && date.Before(introduced) {
+		return Currency{}, ErrorCurrencyInactive
+	}
+	return ISO, nil
+}
+
+// IsActive : returns whether an alpha code named a currency in use on a date
+func IsActive(alpha string, date time.Time) bool {
+	_, err := GetISOFromAlphaAsOf(alpha, date)
+	return err == nil
+}
+
+// ReplacementAsOf : returns the currency in use on a date that an alpha code was replaced by, following
+// every replacement in turn so VEB on 2020-01-01 returns VES
+func ReplacementAsOf(alpha string, date time.Time) (Currency, error) {
+	for seen := 0; seen <= len(HistoricCurrencyList); seen++ {
+		ISO, err := GetISOFromAlphaAsOf(alpha, date)
+		if err != ErrorCurrencyInactive {
+			return ISO, err
+		}
+		h, _ := GetHistoricCurrency(alpha)
+		if h.ReplacedBy == "" || date.Before(h.Introduced) {
+			return Currency{}, ErrorCurrencyInactive
+		}
+		alpha = h.ReplacedBy
+	}
+	return Currency{}, ErrorCurrencyInactive
+}
+
+// isoDate : returns midnight UTC on a date
+func isoDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
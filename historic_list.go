@@ -0,0 +1,386 @@
+package dough
+
+import (
+	"time"
+)
+
+// HistoricCurrencyList - withdrawn currencies keyed by alpha code, with the code that replaced them
+//
+// Withdrawn is the first day the currency is no longer in use, taken from ISO 4217 list three to the
+// month and to the day where the changeover date is known. Introduced is zero when the currency
+// predates the records kept here.
+var HistoricCurrencyList = map[string]HistoricCurrency{
+	"BYR": {
+		Currency: Currency{
+			Unit:                "Belarusian Ruble",
+			Alpha:               "BYR",
+			Numeric:             "974",
+			Symbol:              "\u0042\u0072",
+			Fraction:            0,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Introduced: isoDate(2000, 1, 1),
+		Withdrawn:  isoDate(2017, 1, 1),
+		ReplacedBy: "BYN",
+	},
+	"CYP": {
+		Currency: Currency{
+			Unit:                "Cyprus Pound",
+			Alpha:               "CYP",
+			Numeric:             "196",
+			Symbol:              "\u00a3",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2008, 1, 1),
+		ReplacedBy: "EUR",
+	},
+	"DEM": {
+		Currency: Currency{
+			Unit:                "Deutsche Mark",
+			Alpha:               "DEM",
+			Numeric:             "276",
+			Symbol:              "\u0044\u004d",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2002, 3, 1),
+		ReplacedBy: "EUR",
+	},
+	"EEK": {
+		Currency: Currency{
+			Unit:                "Kroon",
+			Alpha:               "EEK",
+			Numeric:             "233",
+			Symbol:              "\u006b\u0072",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Introduced: isoDate(1992, 6, 20),
+		Withdrawn:  isoDate(2011, 1, 1),
+		ReplacedBy: "EUR",
+	},
+	"ESP": {
+		Currency: Currency{
+			Unit:                "Spanish Peseta",
+			Alpha:               "ESP",
+			Numeric:             "724",
+			Symbol:              "\u20a7",
+			Fraction:            0,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2002, 3, 1),
+		ReplacedBy: "EUR",
+	},
+	"FRF": {
+		Currency: Currency{
+			Unit:                "French Franc",
+			Alpha:               "FRF",
+			Numeric:             "250",
+			Symbol:              "\u20a3",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2002, 3, 1),
+		ReplacedBy: "EUR",
+	},
+	"GHC": {
+		Currency: Currency{
+			Unit:                "Cedi",
+			Alpha:               "GHC",
+			Numeric:             "288",
+			Symbol:              "\u20b5",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2007, 7, 1),
+		ReplacedBy: "GHS",
+	},
+	"HRK": {
+		Currency: Currency{
+			Unit:                "Kuna",
+			Alpha:               "HRK",
+			Numeric:             "191",
+			Symbol:              "\u006b\u006e",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Introduced: isoDate(1994, 5, 30),
+		Withdrawn:  isoDate(2023, 1, 1),
+		ReplacedBy: "EUR",
+	},
+	"ITL": {
+		Currency: Currency{
+			Unit:                "Italian Lira",
+			Alpha:               "ITL",
+			Numeric:             "380",
+			Symbol:              "\u20a4",
+			Fraction:            0,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2002, 3, 1),
+		ReplacedBy: "EUR",
+	},
+	"LTL": {
+		Currency: Currency{
+			Unit:                "Lithuanian Litas",
+			Alpha:               "LTL",
+			Numeric:             "440",
+			Symbol:              "\u004c\u0074",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Introduced: isoDate(1993, 6, 25),
+		Withdrawn:  isoDate(2015, 1, 1),
+		ReplacedBy: "EUR",
+	},
+	"LVL": {
+		Currency: Currency{
+			Unit:                "Latvian Lats",
+			Alpha:               "LVL",
+			Numeric:             "428",
+			Symbol:              "\u004c\u0073",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Introduced: isoDate(1993, 3, 5),
+		Withdrawn:  isoDate(2014, 1, 1),
+		ReplacedBy: "EUR",
+	},
+	"MRO": {
+		Currency: Currency{
+			Unit:                "Ouguiya",
+			Alpha:               "MRO",
+			Numeric:             "478",
+			Symbol:              "\u0055\u004d",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2018, 1, 1),
+		ReplacedBy: "MRU",
+	},
+	"MTL": {
+		Currency: Currency{
+			Unit:                "Maltese Lira",
+			Alpha:               "MTL",
+			Numeric:             "470",
+			Symbol:              "\u004c\u006d",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Introduced: isoDate(1972, 5, 16),
+		Withdrawn:  isoDate(2008, 1, 1),
+		ReplacedBy: "EUR",
+	},
+	"MZM": {
+		Currency: Currency{
+			Unit:                "Mozambique Metical",
+			Alpha:               "MZM",
+			Numeric:             "508",
+			Symbol:              "\u004d\u0054",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2006, 7, 1),
+		ReplacedBy: "MZN",
+	},
+	"NLG": {
+		Currency: Currency{
+			Unit:                "Netherlands Guilder",
+			Alpha:               "NLG",
+			Numeric:             "528",
+			Symbol:              "\u0192",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2002, 3, 1),
+		ReplacedBy: "EUR",
+	},
+	"ROL": {
+		Currency: Currency{
+			Unit:                "Romanian Leu",
+			Alpha:               "ROL",
+			Numeric:             "642",
+			Symbol:              "\u004c",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2005, 7, 1),
+		ReplacedBy: "RON",
+	},
+	"SIT": {
+		Currency: Currency{
+			Unit:                "Tolar",
+			Alpha:               "SIT",
+			Numeric:             "705",
+			Symbol:              "\u0053\u0049\u0054",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Introduced: isoDate(1991, 10, 8),
+		Withdrawn:  isoDate(2007, 1, 1),
+		ReplacedBy: "EUR",
+	},
+	"SKK": {
+		Currency: Currency{
+			Unit:                "Slovak Koruna",
+			Alpha:               "SKK",
+			Numeric:             "703",
+			Symbol:              "\u0053\u006b",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Introduced: isoDate(1993, 2, 8),
+		Withdrawn:  isoDate(2009, 1, 1),
+		ReplacedBy: "EUR",
+	},
+	"STD": {
+		Currency: Currency{
+			Unit:                "Dobra",
+			Alpha:               "STD",
+			Numeric:             "678",
+			Symbol:              "\u0044\u0062",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2018, 1, 1),
+		ReplacedBy: "STN",
+	},
+	"TRL": {
+		Currency: Currency{
+			Unit:                "Turkish Lira",
+			Alpha:               "TRL",
+			Numeric:             "792",
+			Symbol:              "\u0054\u004c",
+			Fraction:            0,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2005, 1, 1),
+		ReplacedBy: "TRY",
+	},
+	"VEB": {
+		Currency: Currency{
+			Unit:                "Bolivar",
+			Alpha:               "VEB",
+			Numeric:             "862",
+			Symbol:              "\u0042\u0073",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Withdrawn:  isoDate(2008, 1, 1),
+		ReplacedBy: "VEF",
+	},
+	"VEF": {
+		Currency: Currency{
+			Unit:                "Bolivar Fuerte",
+			Alpha:               "VEF",
+			Numeric:             "937",
+			Symbol:              "\u0042\u0073",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Introduced: isoDate(2008, 1, 1),
+		Withdrawn:  isoDate(2018, 8, 20),
+		ReplacedBy: "VES",
+	},
+	"ZWR": {
+		Currency: Currency{
+			Unit:                "Zimbabwe Dollar",
+			Alpha:               "ZWR",
+			Numeric:             "935",
+			Symbol:              "\u005a\u0024",
+			Fraction:            2,
+			Decimal:             ".",
+			Grouping:            3,
+			Delimiter:           ",",
+			SymbolPositionFront: true,
+		},
+		Introduced: isoDate(2008, 8, 1),
+		Withdrawn:  isoDate(2009, 6, 1),
+		ReplacedBy: "ZWL",
+	},
+}
+
+// introducedList - the first day each current currency that replaced a HistoricCurrencyList entry was
+// in use, keyed by alpha code
+//
+// Dates are from ISO 4217 amendments. A replacement can be introduced before the code it replaces is
+// withdrawn, as EUR was while DEM notes and coins stayed legal tender until 2002.
+var introducedList = map[string]time.Time{
+	"BYN": isoDate(2016, 7, 1),
+	"EUR": isoDate(1999, 1, 1),
+	"GHS": isoDate(2007, 7, 1),
+	"MRU": isoDate(2018, 1, 1),
+	"MZN": isoDate(2006, 7, 1),
+	"RON": isoDate(2005, 7, 1),
+	"STN": isoDate(2018, 1, 1),
+	"TRY": isoDate(2005, 1, 1),
+	"VES": isoDate(2018, 8, 20),
+	"ZWL": isoDate(2009, 2, 2),
+}
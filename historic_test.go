@@ -0,0 +1,107 @@
+package dough
+
+import (
+	"testing"
+	"time"
+)
+
+var TestGetISOFromAlphaAsOfData = []struct {
+	Alpha  string
+	Date   time.Time
+	Output interface{}
+}{
+	{"USA", isoDate(2000, 1, 1), ErrorInvalidISO.Error()},
+	{"USD", isoDate(1900, 1, 1), "USD"},
+	{"DEM", isoDate(1998, 12, 31), "DEM"},
+	{"dem", isoDate(2002, 2, 28), "DEM"},
+	{"DEM", isoDate(2002, 3, 1), ErrorCurrencyInactive.Error()},
+	{"VEF", isoDate(2007, 12, 31), ErrorCurrencyInactive.Error()},
+	{"VEF", isoDate(2008, 1, 1), "VEF"},
+	{"VEF", isoDate(2018, 8, 20), ErrorCurrencyInactive.Error()},
+	{"HRK", isoDate(2022, 12, 31), "HRK"},
+	{"HRK", isoDate(2023, 1, 1), ErrorCurrencyInactive.Error()},
+	{"EUR", isoDate(1998, 12, 31), ErrorCurrencyInactive.Error()},
+	{"eur", isoDate(1999, 1, 1), "EUR"},
+	{"VES", isoDate(2018, 8, 19), ErrorCurrencyInactive.Error()},
+	{"VES", isoDate(2018, 8, 20), "VES"},
+}
+
+func TestGetISOFromAlphaAsOf(t *testing.T) {
+	for _, v := range TestGetISOFromAlphaAsOfData {
+		result, err := GetISOFromAlphaAsOf(v.Alpha, v.Date)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error("Expected:", v.Output, "Got:", err)
+			}
+		} else if result.Alpha != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result.Alpha)
+		}
+	}
+}
+
+func TestIsActive(t *testing.T) {
+	if !IsActive("LTL", isoDate(2014, 12, 31)) || IsActive("LTL", isoDate(2015, 1, 1)) {
+		t.Error("Expected:", "LTL active until 2015-01-01")
+	}
+	if !IsActive("EUR", isoDate(2015, 1, 1)) || IsActive("XYZ", isoDate(2015, 1, 1)) {
+		t.Error("Expected:", "EUR active and XYZ unknown")
+	}
+	if IsActive("EUR", isoDate(1995, 1, 1)) || IsActive("VES", isoDate(2010, 1, 1)) {
+		t.Error("Expected:", "EUR and VES inactive before they were introduced")
+	}
+}
+
+var TestReplacementAsOfData = []struct {
+	Alpha  string
+	Date   time.Time
+	Output interface{}
+}{
+	{"VEB", isoDate(2005, 1, 1), "VEB"},
+	{"VEB", isoDate(2010, 1, 1), "VEF"},
+	{"VEB", isoDate(2020, 1, 1), "VES"},
+	{"FRF", isoDate(2020, 1, 1), "EUR"},
+	{"MRO", isoDate(2018, 1, 1), "MRU"},
+	{"DEM", isoDate(1999, 6, 1), "DEM"},
+	{"EEK", isoDate(1990, 1, 1), ErrorCurrencyInactive.Error()},
+	{"XYZ", isoDate(2020, 1, 1), ErrorInvalidISO.Error()},
+}
+
+func TestReplacementAsOf(t *testing.T) {
+	for _, v := range TestReplacementAsOfData {
+		result, err := ReplacementAsOf(v.Alpha, v.Date)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error("Expected:", v.Output, "Got:", err)
+			}
+		} else if result.Alpha != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result.Alpha)
+		}
+	}
+}
+
+func TestHistoricCurrencyList(t *testing.T) {
+	for alpha, h := range HistoricCurrencyList {
+		if h.Alpha != alpha || !h.Introduced.Before(h.Withdrawn) {
+			t.Error("Expected:", alpha, "introduced before withdrawn", "Got:", h)
+		}
+		if _, err := ReplacementAsOf(alpha, h.Withdrawn); err != nil {
+			t.Error("Expected:", alpha, "to have a replacement on", h.Withdrawn, "Got:", err)
+		}
+		if _, historic := HistoricCurrencyList[h.ReplacedBy]; !historic {
+			if _, ok := introducedList[h.ReplacedBy]; !ok {
+				t.Error("Expected:", h.ReplacedBy, "in introducedList")
+			}
+		}
+	}
+	for alpha, introduced := range introducedList {
+		if _, err := GetISOFromAlpha(alpha); err != nil {
+			t.Error("Expected:", alpha, "in CurrencyList", "Got:", err)
+		}
+		if IsActive(alpha, introduced.AddDate(0, 0, -1)) || !IsActive(alpha, introduced) {
+			t.Error("Expected:", alpha, "introduced on", introduced)
+		}
+	}
+	if _, err := GetHistoricCurrency("USD"); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+}
@@ -0,0 +1,159 @@
+package dough
+
+import (
+	"encoding/xml"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ISO4217Table - the currencies in an ISO 4217 list one publication keyed by alpha code
+type ISO4217Table struct {
+	Published string
+	Entries   map[string]ISO4217Entry
+}
+
+// ISO4217Entry - one currency from list one with every country that uses it
+type ISO4217Entry struct {
+	Unit      string
+	Alpha     string
+	Numeric   string
+	Fraction  int // FractionNA when ISO publishes N.A. for the minor unit
+	IsFund    bool
+	Countries []string
+}
+
+// ISO4217Diff - what merging an ISO 4217 publication changed in a currency list
+type ISO4217Diff struct {
+	Added           []string
+	Removed         []string
+	FractionChanges []FractionChange
+}
+
+// FractionChange - a currency whose minor unit differs from the ISO 4217 publication
+type FractionChange struct {
+	Alpha string
+	Old   int
+	New   int
+}
+
+// iso4217XML - the layout of the list one XML publication
+type iso4217XML struct {
+	Published string `xml:"Pblshd,attr"`
+	Entries   []struct {
+		Country string `xml:"CtryNm"`
+		Name    struct {
+			Value  string `xml:",chardata"`
+			IsFund string `xml:"IsFund,attr"`
+		} `xml:"CcyNm"`
+		Alpha      string `xml:"Ccy"`
+		Numeric    string `xml:"CcyNbr"`
+		MinorUnits string `xml:"CcyMnrUnts"`
+	} `xml:"CcyTbl>CcyNtry"`
+}
+
+// LoadISO4217 : returns the table read from ISO 4217 list one XML, such as list-one.xml from the ISO maintenance agency
+func LoadISO4217(r io.Reader) (ISO4217Table, error) {
+	var doc iso4217XML
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return ISO4217Table{}, ErrorInvalidISO4217
+	}
+
+	table := ISO4217Table{Published: doc.Published, Entries: map[string]ISO4217Entry{}}
+	for _, v := range doc.Entries {
+		// Entries such as ANTARCTICA have no universal currency
+		alpha := strings.TrimSpace(v.Alpha)
+		if alpha == "" {
+			continue
+		}
+
+		entry, ok := table.Entries[alpha]
+		if !ok {
+			fraction := FractionNA
+			if units := strings.TrimSpace(v.MinorUnits); units != "N.A." {
+				n, err := strconv.Atoi(units)
+				if err != nil || n < 0 {
+					return ISO4217Table{}, ErrorInvalidISO4217
+				}
+				fraction = n
+			}
+			numeric := strings.TrimSpace(v.Numeric)
+			if _, err := strconv.Atoi(numeric); err != nil || len(numeric) > 3 {
+				return ISO4217Table{}, ErrorInvalidISO4217
+			}
+			entry = ISO4217Entry{
+				Unit:     strings.TrimSpace(v.Name.Value),
+				Alpha:    alpha,
+				Numeric:  strings.Repeat("0", 3-len(numeric)) + numeric,
+				Fraction: fraction,
+				IsFund:   v.Name.IsFund != "",
+			}
+		}
+		entry.Countries = append(entry.Countries, strings.TrimSpace(v.Country))
+		table.Entries[alpha] = entry
+	}
+	return table, nil
+}
+
+// LoadISO4217File : returns the table read from an ISO 4217 list one XML file
+func LoadISO4217File(path string) (ISO4217Table, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return ISO4217Table{}, err
+	}
+	defer file.Close()
+	return LoadISO4217(file)
+}
+
+// MergeISO4217 : returns a currency list holding every currency in the table and the differences from the given list
+//
+// The unit, numeric code and fraction come from ISO, the symbol, decimal, grouping and delimiter from
+// the given list. New currencies are formatted like USD with no symbol, currencies missing from the
+// table are removed. Entries ISO publishes with N.A. for the minor unit get FractionNA.
+func MergeISO4217(list map[string]Currency, table ISO4217Table) (map[string]Currency, ISO4217Diff) {
+	merged := map[string]Currency{}
+	diff := ISO4217Diff{}
+	for alpha, entry := range table.Entries {
+		ISO, ok := list[alpha]
+		if !ok {
+			ISO = Currency{Alpha: alpha, Decimal: ".", Grouping: 3, Delimiter: ",", SymbolPositionFront: true}
+			diff.Added = append(diff.Added, alpha)
+		} else if ISO.Fraction != entry.Fraction {
+			diff.FractionChanges = append(diff.FractionChanges, FractionChange{Alpha: alpha, Old: ISO.Fraction, New: entry.Fraction})
+		}
+		ISO.Unit, ISO.Numeric, ISO.Fraction = entry.Unit, entry.Numeric, entry.Fraction
+		merged[alpha] = ISO
+	}
+	for alpha := range list {
+		if _, ok := table.Entries[alpha]; !ok {
+			diff.Removed = append(diff.Removed, alpha)
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Slice(diff.FractionChanges, func(i, j int) bool { return diff.FractionChanges[i].Alpha < diff.FractionChanges[j].Alpha })
+	return merged, diff
+}
+
+// IsEmpty : returns whether the merge changed nothing
+func (d ISO4217Diff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.FractionChanges) == 0
+}
+
+// String : returns the differences one line each, such as "+ XYZ", "- HRK" or "~ ISK fraction 2 -> 0"
+func (d ISO4217Diff) String() string {
+	lines := []string{}
+	for _, alpha := range d.Added {
+		lines = append(lines, "+ "+alpha)
+	}
+	for _, alpha := range d.Removed {
+		lines = append(lines, "- "+alpha)
+	}
+	for _, change := range d.FractionChanges {
+		lines = append(lines, "~ "+change.Alpha+" fraction "+strconv.Itoa(change.Old)+" -> "+strconv.Itoa(change.New))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,88 @@
+package dough
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadISO4217(t *testing.T) {
+	table, err := LoadISO4217File("testdata/list-one.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.Published != "2024-06-25" || len(table.Entries) != 7 {
+		t.Error("Expected:", "2024-06-25 with 7 entries", "Got:", table.Published, len(table.Entries))
+	}
+
+	expected := ISO4217Entry{Unit: "US Dollar", Alpha: "USD", Numeric: "840", Fraction: 2, Countries: []string{"AMERICAN SAMOA", "UNITED STATES OF AMERICA (THE)"}}
+	if !reflect.DeepEqual(table.Entries["USD"], expected) {
+		t.Error("Expected:", expected, "Got:", table.Entries["USD"])
+	}
+	if entry := table.Entries["CLF"]; !entry.IsFund || entry.Fraction != 4 {
+		t.Error("Expected:", "CLF fund with fraction 4", "Got:", entry)
+	}
+	if entry := table.Entries["XAU"]; entry.Fraction != FractionNA {
+		t.Error("Expected:", "XAU with no minor unit", "Got:", entry)
+	}
+
+	if _, err := LoadISO4217File("testdata/missing.xml"); err == nil {
+		t.Error("Expected:", "an error for a missing file")
+	}
+	for _, doc := range []string{
+		"<ISO_4217><CcyTbl>",
+		"<ISO_4217><CcyTbl><CcyNtry><Ccy>USD</Ccy><CcyNbr>840</CcyNbr><CcyMnrUnts>two</CcyMnrUnts></CcyNtry></CcyTbl></ISO_4217>",
+		"<ISO_4217><CcyTbl><CcyNtry><Ccy>USD</Ccy><CcyNbr>8400</CcyNbr><CcyMnrUnts>2</CcyMnrUnts></CcyNtry></CcyTbl></ISO_4217>",
+	} {
+		if _, err := LoadISO4217(strings.NewReader(doc)); err != ErrorInvalidISO4217 {
+			t.Error("Expected:", ErrorInvalidISO4217, "Got:", err)
+		}
+	}
+}
+
+func TestMergeISO4217(t *testing.T) {
+	table, err := LoadISO4217File("testdata/list-one.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list := map[string]Currency{}
+	for _, alpha := range []string{"AFN", "EUR", "HRK", "INR", "ISK", "USD"} {
+		list[alpha] = CurrencyList[alpha]
+	}
+	isk := list["ISK"]
+	isk.Fraction = 2
+	list["ISK"] = isk
+
+	merged, diff := MergeISO4217(list, table)
+	expected := ISO4217Diff{
+		Added:           []string{"CLF", "XAU"},
+		Removed:         []string{"HRK"},
+		FractionChanges: []FractionChange{{Alpha: "ISK", Old: 2, New: 0}},
+	}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Error("Expected:", expected, "Got:", diff)
+	}
+	if diff.String() != "+ CLF\n+ XAU\n- HRK\n~ ISK fraction 2 -> 0" {
+		t.Error("Got:", diff.String())
+	}
+	if diff.IsEmpty() {
+		t.Error("Expected:", "differences")
+	}
+
+	if len(merged) != 7 || merged["INR"] != CurrencyList["INR"] || merged["ISK"] != CurrencyList["ISK"] {
+		t.Error("Expected:", "INR and ISK kept with ISO fractions", "Got:", merged)
+	}
+	clf := Currency{Unit: "Unidad de Fomento", Alpha: "CLF", Numeric: "990", Fraction: 4, Decimal: ".", Grouping: 3, Delimiter: ",", SymbolPositionFront: true}
+	if merged["CLF"] != clf {
+		t.Error("Expected:", clf, "Got:", merged["CLF"])
+	}
+	if merged["XAU"].Fraction != FractionNA {
+		t.Error("Expected:", "XAU with no minor unit", "Got:", merged["XAU"])
+	}
+
+	_, diff = MergeISO4217(merged, table)
+	if !diff.IsEmpty() {
+		t.Error("Expected:", "no differences after merging", "Got:", diff)
+	}
+}
@@ -0,0 +1,82 @@
+package dough
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Locale - number formatting rules for a language and region
+//
+// PositivePattern and NegativePattern place the currency symbol "¤" and the formatted
+// number "#" the way the locale writes them, including any spacing and sign, so
+// "# ¤" renders "1.234,56 €" and "-¤#" renders "-€1,234.56".
+type Locale struct {
+	Tag               string
+	Decimal           string
+	Grouping          int
+	SecondaryGrouping int
+	Delimiter         string
+	PositivePattern   string
+	NegativePattern   string
+}
+
+// GetLocale : returns a Locale struct or an error if the locale tag is not found
+func GetLocale(tag string) (Locale, error) {
+	locale, ok := LocaleList[normalizeLocaleTag(tag)]
+	if !ok {
+		return Locale{}, ErrorInvalidLocale
+	}
+	return locale, nil
+}
+
+// normalizeLocaleTag : returns a tag such as "de_de" in its canonical "de-DE" form
+func normalizeLocaleTag(tag string) string {
+	split := strings.SplitN(strings.Replace(tag, "_", "-", -1), "-", 2)
+	if len(split) == 1 {
+		return strings.ToLower(split[0])
+	}
+	return strings.ToLower(split[0]) + "-" + strings.ToUpper(split[1])
+}
+
+// DisplayLocale : returns a string with full currency formatting for a locale... "num" being the amount, "alpha" being the ISO three digit alphabetic code, "tag" being the locale such as "de-DE".
+func DisplayLocale(num int, alpha string, tag string) (string, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return "", err
+	}
+	locale, err := GetLocale(tag)
+	if err != nil {
+		return "", err
+	}
+	return FormatCurrencyLocale(num, ISO, locale), nil
+}
+
+// FormatCurrencyLocale : returns currency formatting using the symbol and fraction of the currency and the number rules of the locale
+func FormatCurrencyLocale(num int, ISO Currency, locale Locale) string {
+	abs := uint64(num)
+	if num < 0 {
+		abs = -abs
+	}
+	return formatLocaleDigits(strconv.FormatUint(abs, 10), num < 0, ISO, locale)
+}
+
+// formatLocaleDigits : returns locale currency formatting for the unsigned minor unit digits of an amount
+func formatLocaleDigits(digits string, isNegative bool, ISO Currency, locale Locale) string {
+	str := insertDecimalPoint(digits, ISO.MinorDigits())
+	strSplit := strings.Split(str, ".")
+	number := groupDigits(strSplit[0], locale.Grouping, locale.SecondaryGrouping, locale.Delimiter)
+	if len(strSplit) == 2 {
+		number += locale.Decimal + strSplit[1]
+	}
+
+	pattern := locale.PositivePattern
+	if isNegative {
+		pattern = locale.NegativePattern
+	}
+	return applyPattern(pattern, ISO.Symbol, number)
+}
+
+// applyPattern : returns the pattern with "¤" replaced by the symbol and "#" replaced by the number
+func applyPattern(pattern string, symbol string, number string) string {
+	return strings.NewReplacer("¤", symbol, "#", number).Replace(pattern)
+}
@@ -0,0 +1,239 @@
+package dough
+
+// LocaleList - number formatting rules for supported locales, following CLDR
+var LocaleList = map[string]Locale{
+	"da-DK": {
+		Tag:             "da-DK",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       ".",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"de-AT": {
+		Tag:             "de-AT",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       "\u00a0",
+		PositivePattern: "¤\u00a0#",
+		NegativePattern: "-¤\u00a0#",
+	},
+	"de-CH": {
+		Tag:             "de-CH",
+		Decimal:         ".",
+		Grouping:        3,
+		Delimiter:       "\u2019",
+		PositivePattern: "¤\u00a0#",
+		NegativePattern: "¤-#",
+	},
+	"de-DE": {
+		Tag:             "de-DE",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       ".",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"en-AU": {
+		Tag:             "en-AU",
+		Decimal:         ".",
+		Grouping:        3,
+		Delimiter:       ",",
+		PositivePattern: "¤#",
+		NegativePattern: "-¤#",
+	},
+	"en-CA": {
+		Tag:             "en-CA",
+		Decimal:         ".",
+		Grouping:        3,
+		Delimiter:       ",",
+		PositivePattern: "¤#",
+		NegativePattern: "-¤#",
+	},
+	"en-GB": {
+		Tag:             "en-GB",
+		Decimal:         ".",
+		Grouping:        3,
+		Delimiter:       ",",
+		PositivePattern: "¤#",
+		NegativePattern: "-¤#",
+	},
+	"en-IE": {
+		Tag:             "en-IE",
+		Decimal:         ".",
+		Grouping:        3,
+		Delimiter:       ",",
+		PositivePattern: "¤#",
+		NegativePattern: "-¤#",
+	},
+	"en-IN": {
+		Tag:               "en-IN",
+		Decimal:           ".",
+		Grouping:          3,
+		SecondaryGrouping: 2,
+		Delimiter:         ",",
+		PositivePattern:   "¤#",
+		NegativePattern:   "-¤#",
+	},
+	"en-US": {
+		Tag:             "en-US",
+		Decimal:         ".",
+		Grouping:        3,
+		Delimiter:       ",",
+		PositivePattern: "¤#",
+		NegativePattern: "-¤#",
+	},
+	"en-ZA": {
+		Tag:             "en-ZA",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       "\u00a0",
+		PositivePattern: "¤#",
+		NegativePattern: "-¤#",
+	},
+	"es-ES": {
+		Tag:             "es-ES",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       ".",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"es-MX": {
+		Tag:             "es-MX",
+		Decimal:         ".",
+		Grouping:        3,
+		Delimiter:       ",",
+		PositivePattern: "¤#",
+		NegativePattern: "-¤#",
+	},
+	"fi-FI": {
+		Tag:             "fi-FI",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       "\u00a0",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"fr-CA": {
+		Tag:             "fr-CA",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       "\u00a0",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"fr-FR": {
+		Tag:             "fr-FR",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       "\u202f",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"hi-IN": {
+		Tag:               "hi-IN",
+		Decimal:           ".",
+		Grouping:          3,
+		SecondaryGrouping: 2,
+		Delimiter:         ",",
+		PositivePattern:   "¤#",
+		NegativePattern:   "-¤#",
+	},
+	"it-IT": {
+		Tag:             "it-IT",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       ".",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"ja-JP": {
+		Tag:             "ja-JP",
+		Decimal:         ".",
+		Grouping:        3,
+		Delimiter:       ",",
+		PositivePattern: "¤#",
+		NegativePattern: "-¤#",
+	},
+	"ko-KR": {
+		Tag:             "ko-KR",
+		Decimal:         ".",
+		Grouping:        3,
+		Delimiter:       ",",
+		PositivePattern: "¤#",
+		NegativePattern: "-¤#",
+	},
+	"nb-NO": {
+		Tag:             "nb-NO",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       "\u00a0",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"nl-NL": {
+		Tag:             "nl-NL",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       ".",
+		PositivePattern: "¤\u00a0#",
+		NegativePattern: "¤\u00a0-#",
+	},
+	"pl-PL": {
+		Tag:             "pl-PL",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       "\u00a0",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"pt-BR": {
+		Tag:             "pt-BR",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       ".",
+		PositivePattern: "¤\u00a0#",
+		NegativePattern: "-¤\u00a0#",
+	},
+	"pt-PT": {
+		Tag:             "pt-PT",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       "\u00a0",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"ru-RU": {
+		Tag:             "ru-RU",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       "\u00a0",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"sv-SE": {
+		Tag:             "sv-SE",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       "\u00a0",
+		PositivePattern: "#\u00a0¤",
+		NegativePattern: "-#\u00a0¤",
+	},
+	"tr-TR": {
+		Tag:             "tr-TR",
+		Decimal:         ",",
+		Grouping:        3,
+		Delimiter:       ".",
+		PositivePattern: "¤#",
+		NegativePattern: "-¤#",
+	},
+	"zh-CN": {
+		Tag:             "zh-CN",
+		Decimal:         ".",
+		Grouping:        3,
+		Delimiter:       ",",
+		PositivePattern: "¤#",
+		NegativePattern: "-¤#",
+	},
+}
@@ -0,0 +1,98 @@
+package dough
+
+import "testing"
+
+var TestGetLocaleData = []struct {
+	Input  string
+	Output string
+}{
+	{"", ErrorInvalidLocale.Error()},
+	{"xx-YY", ErrorInvalidLocale.Error()},
+	{"de-DE", "de-DE"},
+	{"de_de", "de-DE"},
+	{"EN-ie", "en-IE"},
+}
+
+func TestGetLocale(t *testing.T) {
+	for _, v := range TestGetLocaleData {
+		result, err := GetLocale(v.Input)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result.Tag != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result.Tag)
+		}
+	}
+}
+
+var TestDisplayLocaleData = []struct {
+	Num    int
+	Alpha  string
+	Tag    string
+	Output string
+}{
+	{0, "USA", "en-US", ErrorInvalidISO.Error()},
+	{0, "USD", "xx-YY", ErrorInvalidLocale.Error()},
+	{123456, "EUR", "de-DE", "1.234,56 €"},
+	{-123456, "EUR", "de-DE", "-1.234,56 €"},
+	{123456, "EUR", "en-IE", "€1,234.56"},
+	{-123456, "EUR", "en-IE", "-€1,234.56"},
+	{123456, "EUR", "fr-FR", "1 234,56 €"},
+	{-123456, "EUR", "nl-NL", "€ -1.234,56"},
+	{123456, "EUR", "de-AT", "€ 1 234,56"},
+	{-123456789, "CHF", "de-CH", "CHF-1’234’567.89"},
+	{123456, "USD", "en-US", "$1,234.56"},
+	{123456, "USD", "de-DE", "1.234,56 $"},
+	{1, "USD", "de-DE", "0,01 $"},
+	{1234567, "JPY", "ja-JP", "¥1,234,567"},
+	{-1234567, "BHD", "en-US", "-.د.ب1,234.567"},
+	{100000000, "BRL", "pt-BR", "R$ 1.000.000,00"},
+}
+
+func TestDisplayLocale(t *testing.T) {
+	for _, v := range TestDisplayLocaleData {
+		result, err := DisplayLocale(v.Num, v.Alpha, v.Tag)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result != v.Output {
+			t.Errorf("Expected: %q Got: %q", v.Output, result)
+		}
+	}
+}
+
+func TestFormatCurrencyLocale(t *testing.T) {
+	ISO := Currency{Unit: "Euro", Alpha: "EUR", Numeric: "978", Symbol: "€", Fraction: 2, Decimal: ".", Grouping: 3, Delimiter: ",", SymbolPositionFront: true}
+	locale := Locale{Tag: "xx-XX", Decimal: ",", Grouping: 3, Delimiter: ".", PositivePattern: "# ¤", NegativePattern: "(# ¤)"}
+	if result := FormatCurrencyLocale(-100000, ISO, locale); result != "(1.000,00 €)" {
+		t.Error(result)
+	}
+	locale.Grouping = 0
+	if result := FormatCurrencyLocale(100000, ISO, locale); result != "1000,00 €" {
+		t.Error(result)
+	}
+}
+
+func TestLocaleList(t *testing.T) {
+	for key, v := range LocaleList {
+		if key != v.Tag || key != normalizeLocaleTag(key) {
+			t.Error("Locale key does not match tag:", key, v.Tag)
+		}
+		if v.Decimal == "" || v.Delimiter == "" || v.Decimal == v.Delimiter {
+			t.Error("Locale separators invalid:", key)
+		}
+	}
+}
+
+func TestDisplayLocaleIndianGrouping(t *testing.T) {
+	result, _ := DisplayLocale(123456700, "INR", "en-IN")
+	if result != "₹12,34,567.00" {
+		t.Error(result)
+	}
+	result, _ = DisplayLocale(123456700, "INR", "en-US")
+	if result != "₹1,234,567.00" {
+		t.Error(result)
+	}
+}
@@ -0,0 +1,237 @@
+package dough
+
+import (
+	"math"
+	"math/big"
+)
+
+// Money - an amount in minor units paired with the currency it is denominated in
+type Money struct {
+	amount   int64
+	currency Currency
+}
+
+// NewMoney : returns Money from an amount in minor units... "amount" being the amount, "alpha" being the ISO three digit alphabetic code.
+func NewMoney(amount int64, alpha string) (Money, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: ISO}, nil
+}
+
+// NewMoneyFromString : returns Money parsed from a string using the same rules as StringToInt
+func NewMoneyFromString(num string, alpha string, options ...bool) (Money, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return Money{}, err
+	}
+	amount, err := StringToInt64(num, ISO.Alpha, options...)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: ISO}, nil
+}
+
+// NewMoneyFromFloat : returns Money from a major unit float based upon the ISO fraction
+func NewMoneyFromFloat(amt float64, alpha string) (Money, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return Money{}, err
+	}
+	dec, err := DecimalFromFloat(amt)
+	if err != nil {
+		return Money{}, err
+	}
+	if ISO.Fraction == FractionNA && dec.Round(0, Round).Cmp(dec) != 0 {
+		return Money{}, ErrorNoMinorUnit
+	}
+	amount := dec.MinorUnits(ISO.MinorDigits(), Round)
+	if !amount.IsInt64() {
+		return Money{}, ErrorAmountOverflow
+	}
+	return Money{amount: amount.Int64(), currency: ISO}, nil
+}
+
+// Amount : returns the amount in minor units
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+// Currency : returns the currency the amount is denominated in
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// Alpha : returns the ISO three digit alphabetic code of the currency
+func (m Money) Alpha() string {
+	return m.currency.Alpha
+}
+
+// Float : returns the amount in major units as a float
+func (m Money) Float() float64 {
+	return m.Decimal().Float64()
+}
+
+// Decimal : returns the amount in major units as an exact Decimal
+func (m Money) Decimal() Decimal {
+	return NewDecimal(m.amount, m.currency.MinorDigits())
+}
+
+// String : returns the amount with full currency formatting
+func (m Money) String() string {
+	return m.Display()
+}
+
+// Display : returns a string with full currency formatting
+func (m Money) Display() string {
+	return formatInt64(m.amount, m.currency, displayOptions(m.currency, SymbolDefault, true))
+}
+
+// DisplayWithAlpha : returns a string with full currency formatting with the symbol replaced by the ISO three digit alphabetic code
+func (m Money) DisplayWithAlpha() string {
+	return formatInt64(m.amount, m.currency, displayOptions(m.currency, SymbolAlpha, true))
+}
+
+// DisplayNoSymbol : returns a string with full currency formatting minus the ISO symbol
+func (m Money) DisplayNoSymbol() string {
+	return formatInt64(m.amount, m.currency, displayOptions(m.currency, SymbolNone, true))
+}
+
+// DisplayWithDecimal : returns a string with all currency formatting removed except decimal places
+func (m Money) DisplayWithDecimal() string {
+	return formatInt64(m.amount, m.currency, displayOptions(m.currency, SymbolNone, false))
+}
+
+// Format : returns a string formatted based upon the options
+func (m Money) Format(opts FormatOptions) string {
+	return formatInt64(m.amount, m.currency, opts)
+}
+
+// SameCurrency : returns whether both amounts are denominated in the same currency
+func (m Money) SameCurrency(other Money) bool {
+	return m.currency.Alpha == other.currency.Alpha
+}
+
+// checkCurrency : returns a CurrencyMismatchError if the amounts are in different currencies
+func (m Money) checkCurrency(other Money) error {
+	if !m.SameCurrency(other) {
+		return CurrencyMismatchError{Expected: m.currency.Alpha, Actual: other.currency.Alpha}
+	}
+	return nil
+}
+
+// Add : returns the sum of both amounts or an error if the currencies differ or the sum overflows
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	sum := m.amount + other.amount
+	if (other.amount > 0 && sum < m.amount) || (other.amount < 0 && sum > m.amount) {
+		return Money{}, ErrorArithmeticOverflow
+	}
+	return Money{amount: sum, currency: m.currency}, nil
+}
+
+// Subtract : returns the difference of both amounts or an error if the currencies differ or the difference overflows
+func (m Money) Subtract(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	diff := m.amount - other.amount
+	if (other.amount > 0 && diff > m.amount) || (other.amount < 0 && diff < m.amount) {
+		return Money{}, ErrorArithmeticOverflow
+	}
+	return Money{amount: diff, currency: m.currency}, nil
+}
+
+// Multiply : returns the amount multiplied by an integer or an error if the product overflows
+func (m Money) Multiply(factor int64) (Money, error) {
+	product := new(big.Int).Mul(big.NewInt(m.amount), big.NewInt(factor))
+	if !product.IsInt64() {
+		return Money{}, ErrorArithmeticOverflow
+	}
+	return Money{amount: product.Int64(), currency: m.currency}, nil
+}
+
+// MultiplyRatio : returns the amount multiplied by num/den, rounded to minor units based upon the round mode
+func (m Money) MultiplyRatio(num int64, den int64, mode round) (Money, error) {
+	if den == 0 {
+		return Money{}, ErrorDivisionByZero
+	}
+	product := new(big.Int).Mul(big.NewInt(m.amount), big.NewInt(num))
+	result := divRound(product, big.NewInt(den), mode)
+	if !result.IsInt64() {
+		return Money{}, ErrorArithmeticOverflow
+	}
+	return Money{amount: result.Int64(), currency: m.currency}, nil
+}
+
+// Negate : returns the amount with its sign flipped or an error if it cannot be represented
+func (m Money) Negate() (Money, error) {
+	if m.amount == math.MinInt64 {
+		return Money{}, ErrorArithmeticOverflow
+	}
+	return Money{amount: -m.amount, currency: m.currency}, nil
+}
+
+// Abs : returns the absolute amount or an error if it cannot be represented
+func (m Money) Abs() (Money, error) {
+	if m.amount < 0 {
+		return m.Negate()
+	}
+	return m, nil
+}
+
+// Cmp : returns -1, 0 or 1 if the amount is less than, equal to or greater than other
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.amount < other.amount:
+		return -1, nil
+	case m.amount > other.amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Equals : returns whether both amounts are equal or an error if the currencies differ
+func (m Money) Equals(other Money) (bool, error) {
+	cmp, err := m.Cmp(other)
+	return cmp == 0 && err == nil, err
+}
+
+// GreaterThan : returns whether the amount is greater than other or an error if the currencies differ
+func (m Money) GreaterThan(other Money) (bool, error) {
+	cmp, err := m.Cmp(other)
+	return cmp > 0, err
+}
+
+// LessThan : returns whether the amount is less than other or an error if the currencies differ
+func (m Money) LessThan(other Money) (bool, error) {
+	cmp, err := m.Cmp(other)
+	return cmp < 0, err
+}
+
+// IsZero : returns whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+// IsNegative : returns whether the amount is below zero
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+// Sign : returns -1, 0 or 1 based on whether the amount is negative, zero or positive
+func (m Money) Sign() int {
+	switch {
+	case m.amount < 0:
+		return -1
+	case m.amount > 0:
+		return 1
+	}
+	return 0
+}
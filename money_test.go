@@ -0,0 +1,344 @@
+package dough
+
+import (
+	"math"
+	"testing"
+)
+
+var TestNewMoneyData = []struct {
+	Amount int64
+	Alpha  string
+	Output interface{}
+}{
+	{0, "USA", ErrorInvalidISO.Error()},
+	{0, "USD", "$0.00"},
+	{123456, "usd", "$1,234.56"},
+	{-100, "USD", "$-1.00"},
+	{1000, "JPY", "¥1000"},
+}
+
+func TestNewMoney(t *testing.T) {
+	for _, v := range TestNewMoneyData {
+		result, err := NewMoney(v.Amount, v.Alpha)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result.Display() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result.Display())
+		}
+	}
+}
+
+var TestNewMoneyFromStringData = []struct {
+	Num    string
+	Alpha  string
+	Output interface{}
+}{
+	{"$5", "USA", ErrorInvalidISO.Error()},
+	{"abcd", "USD", ErrorInvalidStringFormat.Error()},
+	{"$5.0", "USD", ErrorInvalidISOFractionMatch.Error()},
+	{"$1,234.56", "USD", int64(123456)},
+	{"-$17.99", "USD", int64(-1799)},
+	{"¥10,000", "JPY", int64(10000)},
+}
+
+func TestNewMoneyFromString(t *testing.T) {
+	for _, v := range TestNewMoneyFromStringData {
+		result, err := NewMoneyFromString(v.Num, v.Alpha)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result.Amount() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result.Amount())
+		}
+	}
+}
+
+var TestNewMoneyFromFloatData = []struct {
+	Amount float64
+	Alpha  string
+	Output interface{}
+}{
+	{1, "USA", ErrorInvalidISO.Error()},
+	{9.99, "USD", int64(999)},
+	{538.92, "USD", int64(53892)},
+	{1.234, "BHD", int64(1234)},
+	{1200, "JPY", int64(1200)},
+	{3, "XAU", int64(3)},
+	{3.5, "XAU", ErrorNoMinorUnit.Error()},
+}
+
+func TestNewMoneyFromFloat(t *testing.T) {
+	for _, v := range TestNewMoneyFromFloatData {
+		result, err := NewMoneyFromFloat(v.Amount, v.Alpha)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result.Amount() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result.Amount())
+		}
+	}
+}
+
+func TestMoneyDisplay(t *testing.T) {
+	m, err := NewMoney(-123456, "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Display() != "$-1,234.56" {
+		t.Error(m.Display())
+	}
+	if m.String() != "$-1,234.56" {
+		t.Error(m.String())
+	}
+	if m.DisplayWithAlpha() != "USD -1,234.56" {
+		t.Error(m.DisplayWithAlpha())
+	}
+	if m.DisplayNoSymbol() != "-1,234.56" {
+		t.Error(m.DisplayNoSymbol())
+	}
+	if m.DisplayWithDecimal() != "-1234.56" {
+		t.Error(m.DisplayWithDecimal())
+	}
+	if m.Float() != -1234.56 {
+		t.Error(m.Float())
+	}
+	if m.Alpha() != "USD" || m.Currency().Numeric != "840" {
+		t.Error(m.Currency())
+	}
+}
+
+var TestMoneyAddSubtractData = []struct {
+	A        int64
+	AlphaA   string
+	B        int64
+	AlphaB   string
+	Sum      interface{}
+	Subtract interface{}
+}{
+	{100, "USD", 250, "USD", int64(350), int64(-150)},
+	{-100, "USD", -250, "USD", int64(-350), int64(150)},
+	{100, "USD", 100, "JPY", CurrencyMismatchError{"USD", "JPY"}.Error(), CurrencyMismatchError{"USD", "JPY"}.Error()},
+}
+
+func TestMoneyAddSubtract(t *testing.T) {
+	for _, v := range TestMoneyAddSubtractData {
+		a, _ := NewMoney(v.A, v.AlphaA)
+		b, _ := NewMoney(v.B, v.AlphaB)
+
+		result, err := a.Add(b)
+		if err != nil {
+			if err.Error() != v.Sum {
+				t.Error(err)
+			}
+		} else if result.Amount() != v.Sum || result.Alpha() != v.AlphaA {
+			t.Error("Expected:", v.Sum, "Got:", result.Amount())
+		}
+
+		result, err = a.Subtract(b)
+		if err != nil {
+			if err.Error() != v.Subtract {
+				t.Error(err)
+			}
+		} else if result.Amount() != v.Subtract || result.Alpha() != v.AlphaA {
+			t.Error("Expected:", v.Subtract, "Got:", result.Amount())
+		}
+	}
+}
+
+func TestCurrencyMismatchError(t *testing.T) {
+	a, _ := NewMoney(1, "USD")
+	b, _ := NewMoney(1, "EUR")
+	_, err := a.Add(b)
+	mismatch, ok := err.(CurrencyMismatchError)
+	if !ok {
+		t.Fatal("Expected CurrencyMismatchError, got:", err)
+	}
+	if mismatch.Expected != "USD" || mismatch.Actual != "EUR" {
+		t.Error(mismatch)
+	}
+}
+
+var TestMoneyOverflowData = []struct {
+	A    int64
+	B    int64
+	Sum  interface{}
+	Diff interface{}
+}{
+	{math.MaxInt64, 1, ErrorArithmeticOverflow.Error(), int64(math.MaxInt64 - 1)},
+	{math.MinInt64, -1, ErrorArithmeticOverflow.Error(), int64(math.MinInt64 + 1)},
+	{math.MinInt64, 1, int64(math.MinInt64 + 1), ErrorArithmeticOverflow.Error()},
+	{math.MaxInt64, -1, int64(math.MaxInt64 - 1), ErrorArithmeticOverflow.Error()},
+	{math.MaxInt64, math.MinInt64, int64(-1), ErrorArithmeticOverflow.Error()},
+}
+
+func TestMoneyOverflow(t *testing.T) {
+	for _, v := range TestMoneyOverflowData {
+		a, _ := NewMoney(v.A, "USD")
+		b, _ := NewMoney(v.B, "USD")
+
+		result, err := a.Add(b)
+		if err != nil {
+			if err.Error() != v.Sum {
+				t.Error(err)
+			}
+		} else if result.Amount() != v.Sum {
+			t.Error("Expected:", v.Sum, "Got:", result.Amount())
+		}
+
+		result, err = a.Subtract(b)
+		if err != nil {
+			if err.Error() != v.Diff {
+				t.Error(err)
+			}
+		} else if result.Amount() != v.Diff {
+			t.Error("Expected:", v.Diff, "Got:", result.Amount())
+		}
+	}
+}
+
+var TestMoneyMultiplyData = []struct {
+	Amount int64
+	Factor int64
+	Output interface{}
+}{
+	{150, 3, int64(450)},
+	{-150, 3, int64(-450)},
+	{150, 0, int64(0)},
+	{math.MaxInt64, 2, ErrorArithmeticOverflow.Error()},
+	{math.MinInt64, -1, ErrorArithmeticOverflow.Error()},
+}
+
+func TestMoneyMultiply(t *testing.T) {
+	for _, v := range TestMoneyMultiplyData {
+		m, _ := NewMoney(v.Amount, "USD")
+		result, err := m.Multiply(v.Factor)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result.Amount() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result.Amount())
+		}
+	}
+}
+
+var TestMoneyMultiplyRatioData = []struct {
+	Amount int64
+	Num    int64
+	Den    int64
+	Round  round
+	Output interface{}
+}{
+	{1000, 1, 3, Round, int64(333)},
+	{1000, 2, 3, Round, int64(667)},
+	{1000, 2, 3, Floor, int64(666)},
+	{1000, 1, 3, Ceil, int64(334)},
+	{-1000, 1, 3, Floor, int64(-334)},
+	{25, 1, 10, Bankers, int64(2)},
+	{35, 1, 10, Bankers, int64(4)},
+	{25, 1, 10, Round, int64(3)},
+	{1000, 1, 0, Round, ErrorDivisionByZero.Error()},
+	{math.MaxInt64, 3, 2, Round, ErrorArithmeticOverflow.Error()},
+	{math.MaxInt64, 2, 2, Round, int64(math.MaxInt64)},
+}
+
+func TestMoneyMultiplyRatio(t *testing.T) {
+	for _, v := range TestMoneyMultiplyRatioData {
+		m, _ := NewMoney(v.Amount, "USD")
+		result, err := m.MultiplyRatio(v.Num, v.Den, v.Round)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result.Amount() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result.Amount())
+		}
+	}
+}
+
+func TestMoneyNegateAbs(t *testing.T) {
+	m, _ := NewMoney(-500, "USD")
+	neg, err := m.Negate()
+	if err != nil || neg.Amount() != 500 {
+		t.Error(neg.Amount(), err)
+	}
+	abs, err := m.Abs()
+	if err != nil || abs.Amount() != 500 {
+		t.Error(abs.Amount(), err)
+	}
+	abs, err = neg.Abs()
+	if err != nil || abs.Amount() != 500 {
+		t.Error(abs.Amount(), err)
+	}
+
+	min, _ := NewMoney(math.MinInt64, "USD")
+	if _, err := min.Negate(); err != ErrorArithmeticOverflow {
+		t.Error("Expected:", ErrorArithmeticOverflow, "Got:", err)
+	}
+	if _, err := min.Abs(); err != ErrorArithmeticOverflow {
+		t.Error("Expected:", ErrorArithmeticOverflow, "Got:", err)
+	}
+}
+
+var TestMoneyCompareData = []struct {
+	A      int64
+	B      int64
+	AlphaB string
+	Cmp    int
+	Err    error
+}{
+	{100, 100, "USD", 0, nil},
+	{100, 200, "USD", -1, nil},
+	{200, 100, "USD", 1, nil},
+	{-200, 100, "USD", -1, nil},
+	{100, 100, "EUR", 0, CurrencyMismatchError{"USD", "EUR"}},
+}
+
+func TestMoneyCompare(t *testing.T) {
+	for _, v := range TestMoneyCompareData {
+		a, _ := NewMoney(v.A, "USD")
+		b, _ := NewMoney(v.B, v.AlphaB)
+
+		cmp, err := a.Cmp(b)
+		if err != v.Err || cmp != v.Cmp {
+			t.Error("Expected:", v.Cmp, v.Err, "Got:", cmp, err)
+		}
+		equals, err := a.Equals(b)
+		if err != v.Err || equals != (v.Err == nil && v.Cmp == 0) {
+			t.Error("Equals got:", equals, err)
+		}
+		greater, err := a.GreaterThan(b)
+		if err != v.Err || greater != (v.Cmp > 0) {
+			t.Error("GreaterThan got:", greater, err)
+		}
+		less, err := a.LessThan(b)
+		if err != v.Err || less != (v.Cmp < 0) {
+			t.Error("LessThan got:", less, err)
+		}
+	}
+}
+
+var TestMoneySignData = []struct {
+	Amount     int64
+	Sign       int
+	IsZero     bool
+	IsNegative bool
+}{
+	{0, 0, true, false},
+	{1, 1, false, false},
+	{-1, -1, false, true},
+	{math.MinInt64, -1, false, true},
+}
+
+func TestMoneySign(t *testing.T) {
+	for _, v := range TestMoneySignData {
+		m, _ := NewMoney(v.Amount, "USD")
+		if m.Sign() != v.Sign || m.IsZero() != v.IsZero || m.IsNegative() != v.IsNegative {
+			t.Error("Got:", m.Sign(), m.IsZero(), m.IsNegative(), "For:", v.Amount)
+		}
+	}
+}
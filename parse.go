@@ -0,0 +1,263 @@
+package dough
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ParseError - returned by the strict parser with the byte offset in Input where parsing failed and why
+type ParseError struct {
+	Input    string
+	Position int
+	Reason   string
+}
+
+// Error : returns the parse failure as a readable string
+func (e ParseError) Error() string {
+	return "Invalid String Format: " + e.Reason + " at position " + strconv.Itoa(e.Position) + " in " + strconv.Quote(e.Input)
+}
+
+// StringToIntStrict : returns a int from a string value that must be written exactly as the ISO formats it
+//
+// Unlike StringToInt nothing is stripped: the symbol, alpha code or numeric code followed by a space
+// may only appear on the side the currency writes it, group separators must fall on the Grouping and
+// SecondaryGrouping boundaries, there may be one decimal followed by exactly the ISO fraction digits,
+// and a negative amount is marked by a single sign before or after the symbol, a trailing minus on
+// either side of the symbol, parentheses or a separate CR suffix.
+func StringToIntStrict(num string, alpha string) (int, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return 0, err
+	}
+	amount, err := parseStrict(num, ISO)
+	if err != nil {
+		return 0, err
+	}
+	if !fitsInt(amount) {
+		return 0, ErrorAmountOverflow
+	}
+	return int(amount.Int64()), nil
+}
+
+// strictParser - walks the input between start and end, narrowing it from both sides as tokens are read
+type strictParser struct {
+	input      string
+	ISO        Currency
+	start, end int
+	isNegative bool
+	marked     bool // a sign or negative style has been read
+}
+
+// parseStrict : returns the minor unit amount of a string value written exactly as the ISO formats it
+func parseStrict(input string, ISO Currency) (*big.Int, error) {
+	p := &strictParser{input: input, ISO: ISO, end: len(input)}
+	p.trimSpace()
+	if p.start == p.end {
+		return nil, p.fail(0, "empty amount")
+	}
+	if err := p.negativeStyle(); err != nil {
+		return nil, err
+	}
+	if err := p.sign(); err != nil {
+		return nil, err
+	}
+
+	// A trailing sign follows a symbol written at the back, as in "1.00إ.د-", and the number otherwise
+	if err := p.trailingSign(); err != nil {
+		return nil, err
+	}
+	if err := p.symbol(); err != nil {
+		return nil, err
+	}
+	if ISO.SymbolPositionFront {
+		if err := p.sign(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.trailingSign(); err != nil {
+		return nil, err
+	}
+	return p.number()
+}
+
+// fail : returns a ParseError at a position
+func (p *strictParser) fail(position int, reason string) error {
+	return ParseError{Input: p.input, Position: position, Reason: reason}
+}
+
+// trimSpace : narrows the input past surrounding spaces
+func (p *strictParser) trimSpace() {
+	for p.start < p.end && p.input[p.start] == ' ' {
+		p.start++
+	}
+	for p.end > p.start && p.input[p.end-1] == ' ' {
+		p.end--
+	}
+}
+
+// negativeStyle : reads surrounding parentheses or a CR suffix
+func (p *strictParser) negativeStyle() error {
+	switch {
+	case p.input[p.start] == '(':
+		if p.input[p.end-1] != ')' {
+			return p.fail(p.start, "unclosed parenthesis")
+		}
+		p.start++
+		p.end--
+	case p.input[p.end-1] == ')':
+		return p.fail(p.end-1, "unexpected parenthesis")
+	case hasCreditSuffix(p.input[p.start:p.end], p.ISO) && p.input[p.end-3] == ' ':
+		p.end -= 2
+	default:
+		return nil
+	}
+	p.trimSpace()
+	p.isNegative, p.marked = true, true
+	return nil
+}
+
+// sign : reads a leading minus or plus
+func (p *strictParser) sign() error {
+	if p.start == p.end || (p.input[p.start] != '-' && p.input[p.start] != '+') {
+		return nil
+	}
+	if p.marked {
+		return p.fail(p.start, "more than one sign")
+	}
+	p.isNegative, p.marked = p.input[p.start] == '-', true
+	p.start++
+	return nil
+}
+
+// trailingSign : reads a trailing minus or plus
+func (p *strictParser) trailingSign() error {
+	if p.start == p.end || (p.input[p.end-1] != '-' && p.input[p.end-1] != '+') {
+		return nil
+	}
+	if p.marked {
+		return p.fail(p.end-1, "more than one sign")
+	}
+	p.isNegative, p.marked = p.input[p.end-1] == '-', true
+	p.end--
+	return nil
+}
+
+// symbol : reads the symbol, alpha code or numeric code on the side the ISO writes it, with an optional space
+func (p *strictParser) symbol() error {
+	str := p.input[p.start:p.end]
+
+	// The longest candidate is tried first so the alpha code "LSL" is not read as the symbol "L"
+	// followed by "SL", and a numeric code must be spaced apart from the digits of the amount
+	candidates := []string{p.ISO.Symbol, p.ISO.Alpha}
+	if len(p.ISO.Alpha) > len(p.ISO.Symbol) {
+		candidates[0], candidates[1] = candidates[1], candidates[0]
+	}
+	for _, symbol := range append(candidates, p.ISO.Numeric) {
+		if symbol == "" {
+			continue
+		}
+		space := symbol == p.ISO.Numeric
+		if p.ISO.SymbolPositionFront && strings.HasPrefix(str, symbol) {
+			if space && !strings.HasPrefix(str[len(symbol):], " ") {
+				continue
+			}
+			p.start += len(symbol)
+			if p.start < p.end && p.input[p.start] == ' ' {
+				p.start++
+			}
+			return nil
+		}
+		if !p.ISO.SymbolPositionFront && strings.HasSuffix(str, symbol) {
+			if space && !strings.HasSuffix(str[:len(str)-len(symbol)], " ") {
+				continue
+			}
+			p.end -= len(symbol)
+			if p.end > p.start && p.input[p.end-1] == ' ' {
+				p.end--
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// number : reads the grouped whole units and the fraction
+func (p *strictParser) number() (*big.Int, error) {
+	if p.start == p.end {
+		return nil, p.fail(p.start, "missing digits")
+	}
+	whole, wholeStart, fraction := p.input[p.start:p.end], p.start, ""
+	for key := 0; key < len(whole); key++ {
+		switch {
+		case whole[key] >= '0' && whole[key] <= '9':
+		case p.ISO.Decimal != "" && strings.HasPrefix(whole[key:], p.ISO.Decimal):
+			key += len(p.ISO.Decimal) - 1
+		case p.ISO.Delimiter != "" && strings.HasPrefix(whole[key:], p.ISO.Delimiter):
+			key += len(p.ISO.Delimiter) - 1
+		default:
+			return nil, p.fail(wholeStart+key, "unexpected character")
+		}
+	}
+	if point := strings.Index(whole, p.ISO.Decimal); p.ISO.Decimal != "" && point >= 0 {
+		whole, fraction = whole[:point], whole[point+len(p.ISO.Decimal):]
+		fractionStart := wholeStart + point + len(p.ISO.Decimal)
+		if p.ISO.Fraction <= 0 {
+			return nil, p.fail(wholeStart+point, "currency has no minor unit")
+		}
+		if next := strings.Index(fraction, p.ISO.Decimal); next >= 0 {
+			return nil, p.fail(fractionStart+next, "more than one decimal")
+		}
+		for key := 0; key < len(fraction); key++ {
+			if fraction[key] < '0' || fraction[key] > '9' {
+				return nil, p.fail(fractionStart+key, "group separator in fraction")
+			}
+		}
+		if len(fraction) != p.ISO.Fraction {
+			return nil, p.fail(fractionStart, "expected "+strconv.Itoa(p.ISO.Fraction)+" fraction digits")
+		}
+	}
+	if whole == "" {
+		return nil, p.fail(wholeStart, "missing digits")
+	}
+
+	digits, err := p.groups(whole, wholeStart)
+	if err != nil {
+		return nil, err
+	}
+	digits += fraction + strings.Repeat("0", p.ISO.MinorDigits()-len(fraction))
+	amount, _ := new(big.Int).SetString(digits, 10)
+	if p.isNegative {
+		amount.Neg(amount)
+	}
+	return amount, nil
+}
+
+// groups : returns the digits of the whole units after checking each group separator falls on a group boundary
+func (p *strictParser) groups(whole string, offset int) (string, error) {
+	var groups []string
+	if p.ISO.Delimiter == "" {
+		groups = []string{whole}
+	} else {
+		groups = strings.Split(whole, p.ISO.Delimiter)
+	}
+
+	primary, secondary := p.ISO.Grouping, p.ISO.SecondaryGrouping
+	if secondary <= 0 {
+		secondary = primary
+	}
+	position := offset
+	for key, group := range groups {
+		if len(groups) > 1 {
+			size := secondary
+			if key == len(groups)-1 {
+				size = primary
+			}
+			if len(group) == 0 || len(group) > size || (key > 0 && len(group) != size) {
+				return "", p.fail(position, "misplaced group separator")
+			}
+		}
+		position += len(group) + len(p.ISO.Delimiter)
+	}
+	return strings.Join(groups, ""), nil
+}
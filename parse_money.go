@@ -0,0 +1,219 @@
+package dough
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+// ParseMoney : returns Money from a string that contains its currency such as "USD 12.50", "12,50 €" or "¥1200"
+//
+// The currency is found by ISO three digit alphabetic code, then ISO numeric code written apart from the
+// amount, then the longest matching symbol. A symbol shared by several currencies resolves to the first
+// of them named in hints, then the CurrenciesBySymbol ranking, so "¥" is JPY. When nothing is found the
+// first hint is used as the default. Either "." or "," is accepted as the decimal and the other as a
+// group separator, and parentheses or a separate "CR" mark a credit.
+func ParseMoney(s string, hints ...string) (Money, error) {
+	for _, hint := range hints {
+		if _, err := GetISOFromAlpha(hint); err != nil {
+			return Money{}, err
+		}
+	}
+
+	// Codes are found first so a code such as SCR is never read as a credit, symbols only once
+	// the credit marker is gone so "CR" cannot match a symbol such as "R"
+	ISO, rest, found := detectAlphaCode(s)
+	if found {
+		// A numeric code left beside the amount, as in "USD 5 840", makes the grouping ambiguous
+		if _, _, twice := detectNumericCode(rest); twice {
+			return Money{}, ErrorInvalidStringFormat
+		}
+	} else {
+		ISO, rest, found = detectNumericCode(s)
+	}
+	rest, isNegative := trimNegative(rest, Currency{})
+	if !found {
+		var err error
+		ISO, rest, err = detectSymbol(rest, hints)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	amount, err := parseDetectedAmount(rest, ISO)
+	if err != nil {
+		return Money{}, err
+	}
+	if isNegative {
+		if amount.Sign() < 0 {
+			return Money{}, ErrorInvalidStringFormat
+		}
+		amount.Neg(amount)
+	}
+	if !amount.IsInt64() {
+		return Money{}, ErrorAmountOverflow
+	}
+	return Money{amount: amount.Int64(), currency: ISO}, nil
+}
+
+// detectAlphaCode : returns the currency of an alpha code in the string and the string with it removed
+func detectAlphaCode(text string) (Currency, string, bool) {
+	// Alpha codes are three letters, or up to eight for registered codes such as USDC, standing on their own
+	fields := strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) })
+	for _, field := range fields {
+		if len(field) < 3 || len(field) > maxAlphaLength {
+			continue
+		}
+		if ISO, err := GetISOFromAlpha(field); err == nil {
+			return ISO, strings.Replace(text, field, "", 1), true
+		}
+	}
+	return Currency{}, text, false
+}
+
+// detectNumericCode : returns the currency of a numeric code in the string and the string with it removed
+//
+// Numeric codes must be apart from the amount, as in "840 12.50" or "(12.50 840)", and are cut from
+// the end they were written at so the negative markers stay with the amount.
+func detectNumericCode(text string) (Currency, string, bool) {
+	bare, _ := trimNegative(text, Currency{})
+	fields := strings.Fields(bare)
+	if len(fields) == 2 {
+		for key, field := range fields {
+			if len(field) != 3 || strings.Trim(field, "0123456789") != "" {
+				continue
+			}
+			if ISO, err := GetISOFromNumeric(field); err == nil {
+				at := strings.Index(text, field)
+				if key == 1 {
+					at = strings.LastIndex(text, field)
+				}
+				return ISO, text[:at] + text[at+len(field):], true
+			}
+		}
+	}
+	return Currency{}, text, false
+}
+
+// detectSymbol : returns the currency of the symbol in the string and the string with it removed, or the first hint
+func detectSymbol(text string, hints []string) (Currency, string, error) {
+	// Symbols are matched longest first so "R$" wins over "$"
+	symbol := ""
+	for value := range DefaultRegistry.Symbols() {
+		if !strings.Contains(text, value) {
+			continue
+		}
+		if len(value) > len(symbol) || (len(value) == len(symbol) && value < symbol) {
+			symbol = value
+		}
+	}
+	if symbol != "" {
+		ISO := preferCurrency(CurrenciesBySymbol(symbol), hints)
+		return ISO, strings.Replace(text, symbol, "", 1), nil
+	}
+
+	if len(hints) > 0 {
+		ISO, err := GetISOFromAlpha(hints[0])
+		return ISO, text, err
+	}
+	return Currency{}, "", ErrorCurrencyNotDetected
+}
+
+// preferCurrency : returns the first ranked candidate named in hints, otherwise the highest ranked candidate
+func preferCurrency(candidates []Currency, hints []string) Currency {
+	for _, alpha := range hints {
+		for _, candidate := range candidates {
+			if candidate.Alpha == strings.ToUpper(alpha) {
+				return candidate
+			}
+		}
+	}
+	return candidates[0]
+}
+
+// parseDetectedAmount : returns the minor unit amount of a string with its currency removed, working out which
+// of "." and "," is the decimal: the last one written when both are, otherwise a single separator that is not
+// followed by exactly three digits unless the ISO fraction is three. The groups before the decimal must
+// match the ISO Grouping and SecondaryGrouping.
+func parseDetectedAmount(text string, ISO Currency) (*big.Int, error) {
+	str := strings.TrimSpace(text)
+	isNegative := false
+	switch {
+	case strings.HasPrefix(str, "-"):
+		str, isNegative = str[1:], true
+	case strings.HasSuffix(str, "-"):
+		str, isNegative = str[:len(str)-1], true
+	case strings.HasPrefix(str, "+"):
+		str = str[1:]
+	}
+	str = strings.TrimSpace(str)
+
+	decimal := byte(0)
+	last := strings.LastIndexAny(str, ".,")
+	if last >= 0 {
+		c := str[last]
+		switch {
+		case strings.Contains(str, ".") && strings.Contains(str, ","):
+			decimal = c
+		case strings.Count(str, string(c)) > 1:
+		case len(str)-last-1 == 3 && ISO.Fraction != 3:
+		default:
+			decimal = c
+		}
+	}
+
+	whole, fraction := str, ""
+	if decimal != 0 {
+		whole, fraction = str[:last], str[last+1:]
+	}
+	digits, err := groupedDigits(whole, ISO)
+	if err != nil || strings.Trim(fraction, "0123456789") != "" || digits+fraction == "" {
+		return nil, ErrorInvalidStringFormat
+	}
+	if digits == "" {
+		digits = "0"
+	}
+	if fraction != "" {
+		digits += "." + fraction
+	}
+	dec, err := ParseDecimal(digits)
+	if err != nil {
+		return nil, ErrorInvalidStringFormat
+	}
+	if ISO.Fraction == FractionNA && dec.Scale() > 0 {
+		return nil, ErrorNoMinorUnit
+	}
+	if dec.Scale() > ISO.MinorDigits() {
+		return nil, ErrorInvalidISOFractionMatch
+	}
+	amount := dec.MinorUnits(ISO.MinorDigits(), Round)
+	if isNegative {
+		amount.Neg(amount)
+	}
+	return amount, nil
+}
+
+// groupedDigits : returns the digits of the whole units after checking they are grouped by one kind of separator
+// on the ISO group boundaries, as the strict parser checks them
+func groupedDigits(whole string, ISO Currency) (string, error) {
+	separator := ""
+	for _, r := range whole {
+		switch {
+		case r >= '0' && r <= '9':
+		case separator == "" && isGroupSeparator(r), separator == string(r):
+			separator = string(r)
+		default:
+			return "", ErrorInvalidStringFormat
+		}
+	}
+	ISO.Delimiter = separator
+	digits, err := (&strictParser{input: whole, ISO: ISO}).groups(whole, 0)
+	if err != nil {
+		return "", ErrorInvalidStringFormat
+	}
+	return digits, nil
+}
+
+// isGroupSeparator : returns whether a rune is written between groups of digits in some locale
+func isGroupSeparator(r rune) bool {
+	return r == '.' || r == ',' || r == ' ' || r == '\'' || r == '\u00a0' || r == '\u202f' || r == '\u2019'
+}
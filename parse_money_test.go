@@ -0,0 +1,83 @@
+package dough
+
+import "testing"
+
+var TestParseMoneyData = []struct {
+	Num    string
+	Hints  []string
+	Alpha  string
+	Amount int64
+	Err    error
+}{
+	{"USD 12.50", nil, "USD", 1250, nil},
+	{"12.50 usd", nil, "USD", 1250, nil},
+	{"EUR1.234,56", nil, "EUR", 123456, nil},
+	{"12,50 €", nil, "EUR", 1250, nil},
+	{"€1,234.56", nil, "EUR", 123456, nil},
+	{"€1,234", nil, "EUR", 123400, nil},
+	{"1.234.567,89 €", nil, "EUR", 123456789, nil},
+	{"¥1200", []string{"JPY"}, "JPY", 1200, nil},
+	{"840 12.50", nil, "USD", 1250, nil},
+	{"12.50 978", nil, "EUR", 1250, nil},
+	{"R$ 10,00", nil, "BRL", 1000, nil},
+	{"CA$5", nil, "CAD", 500, nil},
+	{"1.500 BHD", nil, "BHD", 1500, nil},
+	{"1 234,56 kr", []string{"SEK"}, "SEK", 123456, nil},
+	{"1 234,56 €", nil, "EUR", 123456, nil},
+
+	// Negatives
+	{"-$1.00", nil, "USD", -100, nil},
+	{"$1.00-", nil, "USD", -100, nil},
+	{"(USD 1.00)", nil, "USD", -100, nil},
+	{"£1.00 CR", nil, "GBP", -100, nil},
+	{"R1.00 CR", nil, "ZAR", -100, nil},
+	{"$1.00CR", nil, "USD", -100, nil},
+	{"12.50 SCR", nil, "SCR", 1250, nil},
+	{"12.50 SCR CR", nil, "SCR", -1250, nil},
+	{"(840 12.50)", nil, "USD", -1250, nil},
+	{"12.50 840 CR", nil, "USD", -1250, nil},
+	{"8400.00 840", nil, "USD", 840000, nil},
+
+	// Shared symbols resolve by hint, then the CurrenciesBySymbol ranking
+	{"$12.50", nil, "USD", 1250, nil},
+	{"$12.50", []string{"AUD"}, "AUD", 1250, nil},
+	{"$12.50", []string{"JPY", "cad"}, "USD", 1250, nil},
+	{"¥1200", nil, "JPY", 1200, nil},
+	{"¥1200", []string{"CNY"}, "CNY", 120000, nil},
+
+	// A hint is the default when no currency is written
+	{"12.50", []string{"GBP"}, "GBP", 1250, nil},
+	{"12.50", nil, "", 0, ErrorCurrencyNotDetected},
+	{"$12.50", []string{"USA"}, "", 0, ErrorInvalidISO},
+	{"USD 12.505", nil, "USD", 1250500, nil},
+	{"USD 1,234.567", nil, "", 0, ErrorInvalidISOFractionMatch},
+	{"USD 1-2", nil, "", 0, ErrorInvalidStringFormat},
+	{"USD", nil, "", 0, ErrorInvalidStringFormat},
+	{"(-$1.00)", nil, "", 0, ErrorInvalidStringFormat},
+	{"USD 92233720368547758.08", nil, "", 0, ErrorAmountOverflow},
+
+	// Groups must fall on the currency's group boundaries with one kind of separator
+	{"USD 1,2,3", nil, "", 0, ErrorInvalidStringFormat},
+	{"USD 5 840", nil, "", 0, ErrorInvalidStringFormat},
+	{"€ 1 2", nil, "", 0, ErrorInvalidStringFormat},
+	{"USD 1.2.3", nil, "", 0, ErrorInvalidStringFormat},
+	{"USD 1,23,4.56", nil, "", 0, ErrorInvalidStringFormat},
+	{"USD 1,234 567.00", nil, "", 0, ErrorInvalidStringFormat},
+	{"USD 1,234.5 6", nil, "", 0, ErrorInvalidStringFormat},
+	{"USD 1,234,567.89", nil, "USD", 123456789, nil},
+	{"₹12,34,567.00", nil, "INR", 123456700, nil},
+	{"₹1,234,567.00", nil, "", 0, ErrorInvalidStringFormat},
+	{"CHF 1'234.50", nil, "CHF", 123450, nil},
+	{"$.50", nil, "USD", 50, nil},
+}
+
+func TestParseMoney(t *testing.T) {
+	for _, v := range TestParseMoneyData {
+		result, err := ParseMoney(v.Num, v.Hints...)
+		if err != v.Err {
+			t.Error("Expected:", v.Err, "Got:", err, "For:", v.Num)
+		} else if err == nil && (result.Alpha() != v.Alpha || result.Amount() != v.Amount) {
+			t.Error("Expected:", v.Alpha, v.Amount, "Got:", result.Alpha(), result.Amount(), "For:", v.Num)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package dough
+
+import "testing"
+
+var TestStringToIntStrictData = []struct {
+	Num    string
+	Alpha  string
+	Output interface{}
+}{
+	{"1.00", "USA", ErrorInvalidISO.Error()},
+	{"$1,234.56", "USD", 123456},
+	{"1,234.56", "USD", 123456},
+	{"1234.56", "USD", 123456},
+	{"USD 1,234.56", "USD", 123456},
+	{"LSL 1.23", "LSL", 123},
+	{"L1.23", "LSL", 123},
+	{"TMT 1.23", "TMT", 123},
+	{"840 1,234.56", "USD", 123456},
+	{"8401.00", "USD", 840100},
+	{" $5 ", "USD", 500},
+	{"$0.05", "USD", 5},
+	{"+$1.00", "USD", 100},
+	{"₹12,34,567.00", "INR", 123456700},
+	{"₹1,234.00", "INR", 123400},
+	{"¥100,000", "JPY", 100000},
+	{"1,234.56إ.د", "AED", 123456},
+	{"1,234.56 AED", "AED", 123456},
+	{"$1 234.56", "AUD", 123456},
+	{"$1.234,56", "ARS", 123456},
+
+	// Negative styles
+	{"$-1.00", "USD", -100},
+	{"-$1.00", "USD", -100},
+	{"$1.00-", "USD", -100},
+	{"($1.00)", "USD", -100},
+	{"$1.00 CR", "USD", -100},
+	{"-1.00إ.د", "AED", -100},
+	{"1.00إ.د-", "AED", -100},
+	{"1.00 CHE-", "CHE", -100},
+	{"100 XAU-", "XAU", -100},
+	{"1.00 SCR", "SCR", "Invalid String Format: unexpected character at position 4 in \"1.00 SCR\""},
+
+	// Rejected input with the position and reason
+	{"", "USD", "Invalid String Format: empty amount at position 0 in \"\""},
+	{"1-2-3", "USD", "Invalid String Format: unexpected character at position 1 in \"1-2-3\""},
+	{"12$34", "USD", "Invalid String Format: unexpected character at position 2 in \"12$34\""},
+	{"5.00.00", "USD", "Invalid String Format: more than one decimal at position 4 in \"5.00.00\""},
+	{"$1.5", "USD", "Invalid String Format: expected 2 fraction digits at position 3 in \"$1.5\""},
+	{"$.50", "USD", "Invalid String Format: missing digits at position 1 in \"$.50\""},
+	{"$", "USD", "Invalid String Format: missing digits at position 1 in \"$\""},
+	{"1234,567.00", "USD", "Invalid String Format: misplaced group separator at position 0 in \"1234,567.00\""},
+	{"1,23,456.00", "USD", "Invalid String Format: misplaced group separator at position 2 in \"1,23,456.00\""},
+	{"1,234,567.00", "INR", "Invalid String Format: misplaced group separator at position 2 in \"1,234,567.00\""},
+	{"1.00$", "USD", "Invalid String Format: unexpected character at position 4 in \"1.00$\""},
+	{"إ.د1.00", "AED", "Invalid String Format: unexpected character at position 0 in \"إ.د1.00\""},
+	{"-$1.00-", "USD", "Invalid String Format: more than one sign at position 6 in \"-$1.00-\""},
+	{"$--1.00", "USD", "Invalid String Format: unexpected character at position 2 in \"$--1.00\""},
+	{"(-$1.00)", "USD", "Invalid String Format: more than one sign at position 1 in \"(-$1.00)\""},
+	{"($1.00", "USD", "Invalid String Format: unclosed parenthesis at position 0 in \"($1.00\""},
+	{"$1.00)", "USD", "Invalid String Format: unexpected parenthesis at position 5 in \"$1.00)\""},
+	{"¥100.00", "JPY", "Invalid String Format: currency has no minor unit at position 5 in \"¥100.00\""},
+	{"1.5 XAU", "XAU", "Invalid String Format: currency has no minor unit at position 1 in \"1.5 XAU\""},
+	{"$1.23,4", "USD", "Invalid String Format: group separator in fraction at position 5 in \"$1.23,4\""},
+	{"$92,233,720,368,547,758.08", "USD", ErrorAmountOverflow.Error()},
+}
+
+func TestStringToIntStrict(t *testing.T) {
+	for _, v := range TestStringToIntStrictData {
+		result, err := StringToIntStrict(v.Num, v.Alpha)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error("Expected:", v.Output, "Got:", err)
+			}
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+func TestStringToIntStrictRoundTrip(t *testing.T) {
+	for _, v := range TestNegativeStyleData {
+		parsed, err := StringToIntStrict(v.Output, v.Alpha)
+		if err != nil {
+			t.Error(err)
+		} else if parsed != v.Amount {
+			t.Error("Expected:", v.Amount, "Got:", parsed, "From:", v.Output)
+		}
+	}
+	for _, alpha := range []string{"AED", "CHE", "CLF", "XAU", "XDR"} {
+		for _, style := range []negative{NegativeAfterSymbol, NegativeLeading, NegativeTrailing, NegativeParentheses, NegativeCredit} {
+			formatted, err := DisplayFullNegative(-123456, alpha, style)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			parsed, err := StringToIntStrict(formatted, alpha)
+			if err != nil || parsed != -123456 {
+				t.Error("Expected:", -123456, "Got:", parsed, err, "From:", formatted)
+			}
+		}
+	}
+}
+
+func TestFormatStrictRoundTrip(t *testing.T) {
+	styles := []negative{NegativeAfterSymbol, NegativeLeading, NegativeTrailing, NegativeParentheses, NegativeCredit}
+	for alpha, ISO := range CurrencyList {
+		for _, symbol := range []symbolMode{SymbolDefault, SymbolAlpha, SymbolNumeric, SymbolNone} {
+			for _, style := range styles {
+				for _, amount := range []int64{123456789, -123456789, 0} {
+					opts := DefaultFormatOptions()
+					opts.Symbol, opts.SymbolSpace, opts.Negative = symbol, symbol != SymbolDefault, style
+					if ISO.Fraction <= 0 {
+						opts.Grouping = false
+					}
+					formatted, err := FormatInt64(amount, alpha, opts)
+					if err != nil {
+						t.Fatal(err)
+					}
+					parsed, err := StringToInt64Strict(formatted, alpha)
+					if err != nil || parsed != amount {
+						t.Error("Expected:", amount, "Got:", parsed, err, "From:", formatted)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestStringToIntStrictParseError(t *testing.T) {
+	_, err := StringToInt64Strict("12$34", "USD")
+	parseErr, ok := err.(ParseError)
+	if !ok || parseErr.Position != 2 || parseErr.Reason != "unexpected character" || parseErr.Input != "12$34" {
+		t.Error("Expected:", "ParseError at position 2", "Got:", err)
+	}
+
+	num, err := StringToBigIntStrict("﷼123,456,789,012,345,678,901,234.00", "IRR")
+	if err != nil || num.String() != "12345678901234567890123400" {
+		t.Error(num, err)
+	}
+}
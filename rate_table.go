@@ -0,0 +1,239 @@
+package dough
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateTable - a concurrency safe table of exchange rates by currency pair, keeping every rate loaded by timestamp
+//
+// RateTable is an ExchangeRateProvider returning the latest rate, and On returns a provider for the
+// rates effective on a given day. Rates loaded from files are timestamped at midnight UTC on the day
+// they were published.
+type RateTable struct {
+	mu    sync.RWMutex
+	rates map[string][]Rate // keyed by "FROM/TO", oldest first
+}
+
+// NewRateTable : returns an empty RateTable
+func NewRateTable() *RateTable {
+	return &RateTable{rates: map[string][]Rate{}}
+}
+
+// Add : adds a rate to the history of its pair, replacing a rate with the same timestamp
+func (t *RateTable) Add(rate Rate) error {
+	rate, err := tableRate(rate)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.add(rate)
+	return nil
+}
+
+// Rate : returns the latest rate from one currency to another or ErrorRateNotFound
+func (t *RateTable) Rate(from string, to string) (Rate, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	history := t.rates[ratePair(strings.ToUpper(from), strings.ToUpper(to))]
+	if len(history) == 0 {
+		return Rate{}, ErrorRateNotFound
+	}
+	return history[len(history)-1], nil
+}
+
+// RateOn : returns the rate effective on a date, the latest with a timestamp at or before it, or ErrorRateNotFound
+//
+// Days without a publication such as weekends use the rate from the last day with one.
+func (t *RateTable) RateOn(from string, to string, date time.Time) (Rate, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	history := t.rates[ratePair(strings.ToUpper(from), strings.ToUpper(to))]
+	key := sort.Search(len(history), func(i int) bool { return history[i].Timestamp.After(date) })
+	if key == 0 {
+		return Rate{}, ErrorRateNotFound
+	}
+	return history[key-1], nil
+}
+
+// History : returns every rate from one currency to another, oldest first
+func (t *RateTable) History(from string, to string) []Rate {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	history := t.rates[ratePair(strings.ToUpper(from), strings.ToUpper(to))]
+	return append([]Rate{}, history...)
+}
+
+// On : returns a provider of the rates effective on a date, for use with Convert and ConvertVia
+func (t *RateTable) On(date time.Time) ExchangeRateProvider {
+	return rateTableOn{table: t, date: date}
+}
+
+// rateTableOn - a RateTable seen as of a date
+type rateTableOn struct {
+	table *RateTable
+	date  time.Time
+}
+
+// Rate : returns the rate effective on the date
+func (p rateTableOn) Rate(from string, to string) (Rate, error) {
+	return p.table.RateOn(from, to, p.date)
+}
+
+// ecbXML - the layout of the ECB eurofxref daily, 90 day and historic XML files
+type ecbXML struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// LoadECB : adds the euro reference rates read from ECB eurofxref XML, such as eurofxref-daily.xml
+//
+// Every currency code is checked with GetISOFromAlpha, or GetHistoricCurrency for the withdrawn
+// currencies in eurofxref-hist.xml such as CYP and TRL, and nothing is added if any rate is invalid.
+func (t *RateTable) LoadECB(r io.Reader) error {
+	var doc ecbXML
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return ErrorInvalidRateFile
+	}
+
+	rates := []Rate{}
+	for _, day := range doc.Days {
+		for _, v := range day.Rates {
+			rate, err := parseRate(day.Time, "EUR", v.Currency, v.Rate)
+			if err != nil {
+				return err
+			}
+			rates = append(rates, rate)
+		}
+	}
+	t.addAll(rates)
+	return nil
+}
+
+// LoadECBFile : adds the euro reference rates read from an ECB eurofxref XML file
+func (t *RateTable) LoadECBFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return t.LoadECB(file)
+}
+
+// LoadCSV : adds the rates read from CSV with the columns date, base, quote and rate, such as "2024-06-25,USD,EUR,0.9343"
+//
+// A header row starting with "date" is skipped. Every currency code is checked as LoadECB checks
+// them and nothing is added if any row is invalid.
+func (t *RateTable) LoadCSV(r io.Reader) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return ErrorInvalidRateFile
+	}
+
+	rates := []Rate{}
+	for key, record := range records {
+		if key == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "date") {
+			continue
+		}
+		rate, err := parseRate(record[0], record[1], record[2], record[3])
+		if err != nil {
+			return err
+		}
+		rates = append(rates, rate)
+	}
+	t.addAll(rates)
+	return nil
+}
+
+// LoadCSVFile : adds the rates read from a CSV file, see LoadCSV
+func (t *RateTable) LoadCSVFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return t.LoadCSV(file)
+}
+
+// parseRate : returns a rate from the date, codes and value written in a rate file
+func parseRate(date string, from string, to string, value string) (Rate, error) {
+	day, err := time.Parse("2006-01-02", strings.TrimSpace(date))
+	if err != nil {
+		return Rate{}, ErrorInvalidRateFile
+	}
+	dec, err := ParseDecimal(strings.TrimSpace(value))
+	if err != nil {
+		return Rate{}, ErrorInvalidRateFile
+	}
+	return tableRate(Rate{From: strings.TrimSpace(from), To: strings.TrimSpace(to), Value: dec, Timestamp: day})
+}
+
+// tableRate : returns a rate with its codes checked and upper cased, allowing withdrawn currencies
+// since a table holds the history of rates
+func tableRate(rate Rate) (Rate, error) {
+	from, err := tableCode(rate.From)
+	if err != nil {
+		return Rate{}, err
+	}
+	to, err := tableCode(rate.To)
+	if err != nil {
+		return Rate{}, err
+	}
+	if rate.Value.Sign() <= 0 {
+		return Rate{}, ErrorInvalidRate
+	}
+	rate.From, rate.To = from, to
+	return rate, nil
+}
+
+// tableCode : returns the alpha code of a current currency, or of a withdrawn one in HistoricCurrencyList
+func tableCode(alpha string) (string, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err == nil {
+		return ISO.Alpha, nil
+	}
+	h, herr := GetHistoricCurrency(alpha)
+	if herr != nil {
+		return "", err
+	}
+	return h.Alpha, nil
+}
+
+// addAll : adds rates that have already been validated
+func (t *RateTable) addAll(rates []Rate) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, rate := range rates {
+		t.add(rate)
+	}
+}
+
+// add : inserts a rate in timestamp order, the caller holds the lock
+func (t *RateTable) add(rate Rate) {
+	pair := ratePair(rate.From, rate.To)
+	history := t.rates[pair]
+	key := sort.Search(len(history), func(i int) bool { return !history[i].Timestamp.Before(rate.Timestamp) })
+	if key < len(history) && history[key].Timestamp.Equal(rate.Timestamp) {
+		history[key] = rate
+		return
+	}
+	history = append(history, Rate{})
+	copy(history[key+1:], history[key:])
+	history[key] = rate
+	t.rates[pair] = history
+}
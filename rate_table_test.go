@@ -0,0 +1,140 @@
+package dough
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// loadRates returns a RateTable with the ECB and CSV files in testdata
+func loadRates(t *testing.T) *RateTable {
+	table := NewRateTable()
+	if err := table.LoadECBFile("testdata/eurofxref-hist.xml"); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.LoadCSVFile("testdata/rates.csv"); err != nil {
+		t.Fatal(err)
+	}
+	return table
+}
+
+var TestRateTableRateOnData = []struct {
+	From   string
+	To     string
+	Date   time.Time
+	Output interface{}
+}{
+	{"EUR", "USD", isoDate(2024, 6, 25), "1.0703"},
+	{"EUR", "USD", isoDate(2024, 6, 24), "1.0732"},
+	{"eur", "jpy", isoDate(2024, 6, 21), "170.28"},
+	{"EUR", "USD", isoDate(2024, 6, 23), "1.0692"},
+	{"EUR", "USD", isoDate(2024, 6, 24).Add(15 * time.Hour), "1.0732"},
+	{"EUR", "USD", isoDate(2024, 7, 1), "1.0703"},
+	{"USD", "MXN", isoDate(2024, 6, 24), "18.1370"},
+	{"USD", "CAD", isoDate(2024, 6, 25), "1.3654"},
+	{"EUR", "USD", isoDate(2024, 6, 20), "1.4721"},
+	{"EUR", "CYP", isoDate(2008, 1, 2), "0.585274"},
+	{"eur", "skk", isoDate(2024, 6, 25), "33.583"},
+	{"EUR", "USD", isoDate(2007, 12, 30), ErrorRateNotFound.Error()},
+	{"USD", "EUR", isoDate(2024, 6, 25), ErrorRateNotFound.Error()},
+}
+
+func TestRateTableRateOn(t *testing.T) {
+	table := loadRates(t)
+	for _, v := range TestRateTableRateOnData {
+		rate, err := table.RateOn(v.From, v.To, v.Date)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error("Expected:", v.Output, "Got:", err)
+			}
+		} else if rate.Value.String() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", rate.Value, v)
+		}
+	}
+
+	rate, err := table.Rate("EUR", "THB")
+	if err != nil || rate.Value.String() != "39.315" || !rate.Timestamp.Equal(isoDate(2024, 6, 25)) {
+		t.Error("Expected:", "39.315 on 2024-06-25", "Got:", rate, err)
+	}
+	if history := table.History("EUR", "GBP"); len(history) != 3 || history[0].Value.String() != "0.84575" {
+		t.Error("Expected:", "3 rates from 2024-06-21", "Got:", history)
+	}
+}
+
+func TestRateTableConvert(t *testing.T) {
+	table := loadRates(t)
+	result, rate, err := Convert(10000, "EUR", "USD", table.On(isoDate(2024, 6, 22)), Round)
+	if err != nil || result != 10692 || !rate.Timestamp.Equal(isoDate(2024, 6, 21)) {
+		t.Error("Expected:", 10692, "Got:", result, rate, err)
+	}
+	result, _, err = ConvertVia(10000, "THB", "PLN", "EUR", table.On(isoDate(2024, 6, 25)), Round, RoundFinal)
+	if err != nil || result != 1097 {
+		t.Error("Expected:", 1097, "Got:", result, err)
+	}
+}
+
+func TestRateTableAdd(t *testing.T) {
+	table := NewRateTable()
+	for _, day := range []int{25, 21, 24} {
+		if err := table.Add(Rate{From: "usd", To: "eur", Value: NewDecimal(int64(9300+day), 4), Timestamp: isoDate(2024, 6, day)}); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := table.Add(Rate{From: "USD", To: "EUR", Value: NewDecimal(9999, 4), Timestamp: isoDate(2024, 6, 24)}); err != nil {
+		t.Error(err)
+	}
+	history := table.History("USD", "EUR")
+	if len(history) != 3 || history[0].Value.String() != "0.9321" || history[1].Value.String() != "0.9999" || history[2].Value.String() != "0.9325" {
+		t.Error("Expected:", "0.9321 0.9999 0.9325", "Got:", history)
+	}
+
+	if err := table.Add(Rate{From: "USD", To: "EUA", Value: NewDecimal(1, 0)}); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+	if err := table.Add(Rate{From: "DEM", To: "EUR", Value: NewDecimal(195583, 5), Timestamp: isoDate(1999, 1, 1)}); err != nil {
+		t.Error(err)
+	}
+	if err := table.Add(Rate{From: "USD", To: "EUR", Value: NewDecimal(-1, 0)}); err != ErrorInvalidRate {
+		t.Error("Expected:", ErrorInvalidRate, "Got:", err)
+	}
+}
+
+var TestRateTableLoadErrorsData = []struct {
+	Format string
+	Input  string
+	Output string
+}{
+	{"xml", "<gesmes:Envelope><Cube>", ErrorInvalidRateFile.Error()},
+	{"xml", "<Envelope><Cube><Cube time='2024-06-25'><Cube currency='USD' rate='1.07'/><Cube currency='EUA' rate='1'/></Cube></Cube></Envelope>", ErrorInvalidISO.Error()},
+	{"xml", "<Envelope><Cube><Cube time='25/06/2024'><Cube currency='USD' rate='1.07'/></Cube></Cube></Envelope>", ErrorInvalidRateFile.Error()},
+	{"xml", "<Envelope><Cube><Cube time='2024-06-25'><Cube currency='USD' rate='N/A'/></Cube></Cube></Envelope>", ErrorInvalidRateFile.Error()},
+	{"csv", "2024-06-25,USD,EUR", ErrorInvalidRateFile.Error()},
+	{"csv", "2024-06-25,USD,EUR,0.93\n2024-06-25,USD,EUA,0.93", ErrorInvalidISO.Error()},
+	{"csv", "2024-06-25,USD,EUR,0", ErrorInvalidRate.Error()},
+	{"csv", "date,base,quote,rate\ndate,base,quote,rate", ErrorInvalidRateFile.Error()},
+}
+
+func TestRateTableLoadErrors(t *testing.T) {
+	for _, v := range TestRateTableLoadErrorsData {
+		table := NewRateTable()
+		var err error
+		if v.Format == "xml" {
+			err = table.LoadECB(strings.NewReader(v.Input))
+		} else {
+			err = table.LoadCSV(strings.NewReader(v.Input))
+		}
+		if err == nil || err.Error() != v.Output {
+			t.Error("Expected:", v.Output, "Got:", err)
+		}
+		if _, err := table.Rate("EUR", "USD"); err != ErrorRateNotFound {
+			t.Error("Expected:", "nothing loaded", "Got:", err)
+		}
+		if _, err := table.Rate("USD", "EUR"); err != ErrorRateNotFound {
+			t.Error("Expected:", "nothing loaded", "Got:", err)
+		}
+	}
+
+	if err := NewRateTable().LoadECBFile("testdata/missing.xml"); err == nil {
+		t.Error("Expected:", "an error for a missing file")
+	}
+}
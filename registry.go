@@ -0,0 +1,322 @@
+package dough
+
+import (
+	"sort"
+	"sync"
+)
+
+// Registry - a concurrency safe set of currencies indexed by alpha code, numeric code and symbol
+//
+// The package functions such as GetISOFromAlpha, DisplayFull and StringToInt look currencies up in
+// DefaultRegistry, so currencies registered there are usable everywhere. Lookups take a read lock
+// and may run alongside Register and Unregister from other goroutines.
+type Registry struct {
+	mu          sync.RWMutex
+	currencies  map[string]Currency
+	numeric     map[string]string   // alpha codes keyed by ISO numeric code
+	symbols     map[string][]string // alpha codes keyed by symbol, in symbolRank order then by alpha code
+	preferences map[string][]string // alpha codes keyed by symbol, set with SetSymbolPreference
+}
+
+// DefaultRegistry - the registry used by the package functions, seeded from CurrencyList
+//
+// CurrencyList is copied when the package loads, later writes to it are not seen; use
+// DefaultRegistry.Register and DefaultRegistry.Unregister instead.
+var DefaultRegistry = NewRegistry(CurrencyList)
+
+// NewRegistry : returns a Registry seeded with a copy of a currency list such as CurrencyList
+func NewRegistry(list map[string]Currency) *Registry {
+	r := &Registry{
+		currencies:  make(map[string]Currency, len(list)),
+		numeric:     make(map[string]string, len(list)),
+		symbols:     map[string][]string{},
+		preferences: map[string][]string{},
+	}
+	for _, ISO := range list {
+		r.add(ISO)
+	}
+	return r
+}
+
+// Register : adds a currency or replaces the currency with the same alpha code
+func (r *Registry) Register(ISO Currency) error {
+	if ISO.Alpha == "" || !isUpperAlnum(ISO.Alpha) || len(ISO.Alpha) > maxAlphaLength || ISO.Fraction < FractionNA {
+		return ErrorInvalidCurrency
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if alpha, ok := r.numeric[ISO.Numeric]; ok && ISO.Numeric != "" && alpha != ISO.Alpha {
+		return ErrorInvalidCurrency
+	}
+	r.remove(ISO.Alpha)
+	r.add(ISO)
+	return nil
+}
+
+// RegisterList : adds every currency in a list such as CryptoCurrencyList, stopping at the first that cannot be registered
+func (r *Registry) RegisterList(list map[string]Currency) error {
+	alphas := make([]string, 0, len(list))
+	for alpha := range list {
+		alphas = append(alphas, alpha)
+	}
+	sort.Strings(alphas)
+	for _, alpha := range alphas {
+		if err := r.Register(list[alpha]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unregister : removes a currency by ISO three digit alphabetic code
+func (r *Registry) Unregister(alpha string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ISO, ok := r.lookup(alpha)
+	if !ok {
+		return ErrorInvalidISO
+	}
+	r.remove(ISO.Alpha)
+	return nil
+}
+
+// Lookup : returns the currency for an alpha code in any case or an error if it is not registered
+func (r *Registry) Lookup(alpha string) (Currency, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ISO, ok := r.lookup(alpha)
+	if !ok {
+		return Currency{}, ErrorInvalidISO
+	}
+	return ISO, nil
+}
+
+// LookupNumeric : returns the currency for an ISO numeric code or an error if it is not registered
+func (r *Registry) LookupNumeric(num string) (Currency, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	alpha, ok := r.numeric[num]
+	if !ok {
+		return Currency{}, ErrorInvalidISO
+	}
+	return r.currencies[alpha], nil
+}
+
+// Currencies : returns every registered currency sorted by alpha code
+func (r *Registry) Currencies() []Currency {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	currencies := make([]Currency, 0, len(r.currencies))
+	for _, ISO := range r.currencies {
+		currencies = append(currencies, ISO)
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i].Alpha < currencies[j].Alpha })
+	return currencies
+}
+
+// ListCurrencies : returns a list of currencies
+func (r *Registry) ListCurrencies(list []string) ([]Currency, error) {
+	currencies := []Currency{}
+	for _, v := range list {
+		ISO, err := r.Lookup(v)
+		if err != nil {
+			return nil, err
+		}
+		currencies = append(currencies, ISO)
+	}
+	return currencies, nil
+}
+
+// DisplayFull : returns a string with full currency formatting... "num" being the amount, "alpha" being the registered alphabetic code.
+func (r *Registry) DisplayFull(num int, alpha string) (string, error) {
+	ISO, err := r.Lookup(alpha)
+	if err != nil {
+		return "", err
+	}
+	return formatInt64(int64(num), ISO, displayOptions(ISO, SymbolDefault, true)), nil
+}
+
+// StringToInt : returns a int from a string value using the same rules as the package StringToInt
+func (r *Registry) StringToInt(num string, alpha string, options ...bool) (int, error) {
+	ISO, err := r.Lookup(alpha)
+	if err != nil {
+		return 0, err
+	}
+	amount, err := parseAmount(num, ISO, options...)
+	if err != nil {
+		return 0, err
+	}
+	if !fitsInt(amount) {
+		return 0, ErrorAmountOverflow
+	}
+	return int(amount.Int64()), nil
+}
+
+// CurrenciesBySymbol : returns every currency using a symbol, ranked with the preferred currency first
+func (r *Registry) CurrenciesBySymbol(symbol string) []Currency {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	preferred, alphas := r.preferences[symbol], r.symbols[symbol]
+	currencies := make([]Currency, 0, len(alphas))
+	for _, alpha := range preferred {
+		currencies = append(currencies, r.currencies[alpha])
+	}
+	for _, alpha := range alphas {
+		if !containsString(preferred, alpha) {
+			currencies = append(currencies, r.currencies[alpha])
+		}
+	}
+	return currencies
+}
+
+// SetSymbolPreference : sets the ranking for a symbol to the given alpha codes, no codes resets it to the default
+func (r *Registry) SetSymbolPreference(symbol string, alphas ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	list := make([]string, 0, len(alphas))
+	for _, alpha := range alphas {
+		ISO, ok := r.lookup(alpha)
+		if !ok {
+			return ErrorInvalidISO
+		}
+		if ISO.Symbol != symbol {
+			return ErrorInvalidSymbol
+		}
+		list = append(list, ISO.Alpha)
+	}
+	if len(list) == 0 {
+		delete(r.preferences, symbol)
+		return nil
+	}
+	r.preferences[symbol] = list
+	return nil
+}
+
+// Symbols : returns every symbol used by a registered currency and how many currencies use it
+func (r *Registry) Symbols() map[string]int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	output := make(map[string]int, len(r.symbols))
+	for symbol, alphas := range r.symbols {
+		output[symbol] = len(alphas)
+	}
+	return output
+}
+
+// maxAlphaLength - the longest alpha code a registry accepts, so lookups can upper case on the stack
+const maxAlphaLength = 8
+
+// lookup : returns the currency for an alpha code in any case, the caller holds the lock
+func (r *Registry) lookup(alpha string) (Currency, bool) {
+	if ISO, ok := r.currencies[alpha]; ok {
+		return ISO, true
+	}
+
+	// Upper case into a stack buffer so lower case codes do not allocate either
+	var buf [maxAlphaLength]byte
+	if len(alpha) > len(buf) {
+		return Currency{}, false
+	}
+	for key := 0; key < len(alpha); key++ {
+		c := alpha[key]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		buf[key] = c
+	}
+	ISO, ok := r.currencies[string(buf[:len(alpha)])]
+	return ISO, ok
+}
+
+// add : indexes a currency, the caller holds the lock
+func (r *Registry) add(ISO Currency) {
+	r.currencies[ISO.Alpha] = ISO
+	if ISO.Numeric != "" {
+		r.numeric[ISO.Numeric] = ISO.Alpha
+	}
+	if ISO.Symbol != "" {
+		alphas := append(r.symbols[ISO.Symbol], ISO.Alpha)
+		sort.Slice(alphas, func(i, j int) bool {
+			ri, rj := symbolRank(ISO.Symbol, alphas[i]), symbolRank(ISO.Symbol, alphas[j])
+			if ri != rj {
+				return ri < rj
+			}
+			return alphas[i] < alphas[j]
+		})
+		r.symbols[ISO.Symbol] = alphas
+	}
+}
+
+// remove : drops a currency from every index, the caller holds the lock
+func (r *Registry) remove(alpha string) {
+	ISO, ok := r.currencies[alpha]
+	if !ok {
+		return
+	}
+	delete(r.currencies, alpha)
+	if r.numeric[ISO.Numeric] == alpha {
+		delete(r.numeric, ISO.Numeric)
+	}
+
+	alphas := []string{}
+	for _, value := range r.symbols[ISO.Symbol] {
+		if value != alpha {
+			alphas = append(alphas, value)
+		}
+	}
+	if len(alphas) == 0 {
+		delete(r.symbols, ISO.Symbol)
+	} else {
+		r.symbols[ISO.Symbol] = alphas
+	}
+
+	preferred := []string{}
+	for _, value := range r.preferences[ISO.Symbol] {
+		if value != alpha {
+			preferred = append(preferred, value)
+		}
+	}
+	if len(preferred) == 0 {
+		delete(r.preferences, ISO.Symbol)
+	} else {
+		r.preferences[ISO.Symbol] = preferred
+	}
+}
+
+// symbolLeaders - alpha codes ranked ahead of TopCurrencies order for a shared symbol, as a bare "¥" is written for yen
+var symbolLeaders = map[string][]string{
+	"¥": {"JPY"},
+}
+
+// symbolRank : returns the position of an alpha code among the currencies using a symbol, symbolLeaders first then TopCurrencies order
+func symbolRank(symbol string, alpha string) int {
+	leaders := symbolLeaders[symbol]
+	for key, value := range leaders {
+		if value == alpha {
+			return key - len(leaders)
+		}
+	}
+	return topRank(alpha)
+}
+
+// topRank : returns the position of an alpha code in TopCurrencies, or the length of the list if it is not there
+func topRank(alpha string) int {
+	for key, value := range topAlphas {
+		if value == alpha {
+			return key
+		}
+	}
+	return len(topAlphas)
+}
+
+// isUpperAlnum : returns whether a code is only upper case letters and digits
+func isUpperAlnum(str string) bool {
+	for key := 0; key < len(str); key++ {
+		c := str[key]
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
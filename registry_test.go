@@ -0,0 +1,190 @@
+package dough
+
+import "testing"
+
+func TestDefaultRegistryMatchesCurrencyList(t *testing.T) {
+	for alpha, value := range CurrencyList {
+		if result, err := GetAlphaFromISONumeric(value.Numeric); err != nil || result != alpha {
+			t.Error("Expected:", alpha, "Got:", result, err)
+		}
+		if !containsString(alphaCodes(CurrenciesBySymbol(value.Symbol)), alpha) {
+			t.Error("Expected:", alpha, "in symbol index for", value.Symbol)
+		}
+	}
+}
+
+func TestLookupAllocations(t *testing.T) {
+	lookups := map[string]func(){
+		"GetISOFromAlpha":        func() { GetISOFromAlpha("USD") },
+		"GetISOFromAlphaLower":   func() { GetISOFromAlpha("usd") },
+		"GetISOFromAlphaInvalid": func() { GetISOFromAlpha("USA") },
+		"GetISOFromNumeric":      func() { GetISOFromNumeric("840") },
+		"GetISOCodeFromNumeric":  func() { GetISOCodeFromNumeric("840") },
+		"GetAlphaFromISONumeric": func() { GetAlphaFromISONumeric("840") },
+	}
+	for name, lookup := range lookups {
+		if allocs := testing.AllocsPerRun(100, lookup); allocs != 0 {
+			t.Error("Expected:", 0, "Got:", allocs, "allocations in", name)
+		}
+	}
+}
+
+func BenchmarkGetISOFromAlpha(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		GetISOFromAlpha("USD")
+	}
+}
+
+func BenchmarkGetISOFromAlphaLast(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		GetISOFromAlpha("ZWL")
+	}
+}
+
+func BenchmarkGetISOFromAlphaLower(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		GetISOFromAlpha("usd")
+	}
+}
+
+func BenchmarkGetISOFromNumeric(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		GetISOFromNumeric("840")
+	}
+}
+
+func BenchmarkGetAlphaFromISONumeric(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		GetAlphaFromISONumeric("932")
+	}
+}
+
+func BenchmarkCurrenciesBySymbol(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		CurrenciesBySymbol("€")
+	}
+}
+
+// loyaltyPoints is a custom currency with no minor unit
+var loyaltyPoints = Currency{Unit: "Loyalty Points", Alpha: "PTS", Symbol: "★", Fraction: 0, Decimal: ".", Grouping: 3, Delimiter: ",", SymbolPositionFront: false}
+
+// storeCredit is a custom currency sharing the dollar symbol
+var storeCredit = Currency{Unit: "Store Credit", Alpha: "STC", Numeric: "900", Symbol: "$", Fraction: 2, Decimal: ".", Grouping: 3, Delimiter: ",", SymbolPositionFront: true}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry(CurrencyList)
+	if err := r.Register(loyaltyPoints); err != nil {
+		t.Error(err)
+	}
+	if err := r.Register(storeCredit); err != nil {
+		t.Error(err)
+	}
+
+	ISO, err := r.Lookup("pts")
+	if err != nil || ISO != loyaltyPoints {
+		t.Error("Expected:", loyaltyPoints, "Got:", ISO, err)
+	}
+	ISO, err = r.LookupNumeric("900")
+	if err != nil || ISO != storeCredit {
+		t.Error("Expected:", storeCredit, "Got:", ISO, err)
+	}
+	if result := alphaCodes(r.CurrenciesBySymbol("$")); len(result) != 25 || result[0] != "USD" || !containsString(result, "STC") {
+		t.Error("Expected:", "USD first and STC in", "Got:", result)
+	}
+
+	result, err := r.DisplayFull(1234567, "PTS")
+	if err != nil || result != "1234567★" {
+		t.Error("Expected:", "1234567★", "Got:", result, err)
+	}
+	amount, err := r.StringToInt("$1,234.56", "STC")
+	if err != nil || amount != 123456 {
+		t.Error("Expected:", 123456, "Got:", amount, err)
+	}
+	list, err := r.ListCurrencies([]string{"USD", "PTS"})
+	if err != nil || len(list) != 2 || list[1] != loyaltyPoints {
+		t.Error("Expected:", "USD and PTS", "Got:", list, err)
+	}
+
+	// The package functions only see DefaultRegistry
+	if _, err := GetISOFromAlpha("PTS"); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+
+	if err := r.Unregister("pts"); err != nil {
+		t.Error(err)
+	}
+	if err := r.Unregister("PTS"); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+	if _, err := r.DisplayFull(1, "PTS"); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+	if _, err := r.StringToInt("1", "PTS"); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+	if _, err := r.ListCurrencies([]string{"PTS"}); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+	if len(r.Currencies()) != len(CurrencyList)+1 {
+		t.Error("Expected:", len(CurrencyList)+1, "Got:", len(r.Currencies()))
+	}
+}
+
+func TestRegistryRegisterErrors(t *testing.T) {
+	r := NewRegistry(CurrencyList)
+	for _, ISO := range []Currency{{}, {Alpha: "pts"}, {Alpha: "TOOLONGCODE"}, {Alpha: "PTS", Fraction: -2}, {Alpha: "PTS", Numeric: "840"}} {
+		if err := r.Register(ISO); err != ErrorInvalidCurrency {
+			t.Error("Expected:", ErrorInvalidCurrency, "Got:", err, "For:", ISO)
+		}
+	}
+
+	// Replacing a currency keeps its numeric code
+	usd := CurrencyList["USD"]
+	usd.Unit = "Dollar"
+	if err := r.Register(usd); err != nil {
+		t.Error(err)
+	}
+	if ISO, err := r.LookupNumeric("840"); err != nil || ISO.Unit != "Dollar" {
+		t.Error("Expected:", "Dollar", "Got:", ISO.Unit, err)
+	}
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	if err := DefaultRegistry.Register(loyaltyPoints); err != nil {
+		t.Error(err)
+	}
+	defer DefaultRegistry.Unregister("PTS")
+
+	result, err := DisplayFull(1500, "PTS")
+	if err != nil || result != "1500★" {
+		t.Error("Expected:", "1500★", "Got:", result, err)
+	}
+	m, err := ParseMoney("1500 ★")
+	if err != nil || m.Alpha() != "PTS" || m.Amount() != 1500 {
+		t.Error("Expected:", "1500 PTS", "Got:", m.Amount(), m.Alpha(), err)
+	}
+}
+
+func TestRegistryConcurrency(t *testing.T) {
+	r := NewRegistry(CurrencyList)
+	done := make(chan bool)
+	go func() {
+		for n := 0; n < 1000; n++ {
+			r.Register(loyaltyPoints)
+			r.Unregister("PTS")
+		}
+		done <- true
+	}()
+	for n := 0; n < 1000; n++ {
+		r.Lookup("USD")
+		r.DisplayFull(n, "PTS")
+		r.CurrenciesBySymbol("$")
+	}
+	<-done
+}
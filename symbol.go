@@ -0,0 +1,51 @@
+package dough
+
+// CurrenciesBySymbol : returns every currency in DefaultRegistry using a symbol, ranked with the preferred currency first
+//
+// Currencies named with SetSymbolPreference come first in the order given, then JPY for "¥", then
+// those in TopCurrencies order, then the rest by alpha code.
+func CurrenciesBySymbol(symbol string) []Currency {
+	return DefaultRegistry.CurrenciesBySymbol(symbol)
+}
+
+// PreferredCurrencyForSymbol : returns the highest ranked currency using a symbol or an error if no currency uses it
+func PreferredCurrencyForSymbol(symbol string) (Currency, error) {
+	currencies := CurrenciesBySymbol(symbol)
+	if len(currencies) == 0 {
+		return Currency{}, ErrorInvalidSymbol
+	}
+	return currencies[0], nil
+}
+
+// SetSymbolPreference : sets the ranking for a symbol to the given ISO three digit alphabetic codes, no codes resets it to the default
+func SetSymbolPreference(symbol string, alphas ...string) error {
+	return DefaultRegistry.SetSymbolPreference(symbol, alphas...)
+}
+
+// AmbiguousSymbols : returns the ranked alpha codes of every symbol shared by more than one currency
+func AmbiguousSymbols() map[string][]string {
+	output := map[string][]string{}
+	for symbol, count := range DefaultRegistry.Symbols() {
+		if count > 1 {
+			for _, ISO := range CurrenciesBySymbol(symbol) {
+				output[symbol] = append(output[symbol], ISO.Alpha)
+			}
+		}
+	}
+	return output
+}
+
+// IsAmbiguousSymbol : returns whether more than one currency uses a symbol
+func IsAmbiguousSymbol(symbol string) bool {
+	return DefaultRegistry.Symbols()[symbol] > 1
+}
+
+// containsString : returns whether a list contains a string
+func containsString(list []string, str string) bool {
+	for _, value := range list {
+		if value == str {
+			return true
+		}
+	}
+	return false
+}
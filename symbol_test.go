@@ -0,0 +1,80 @@
+package dough
+
+import (
+	"reflect"
+	"testing"
+)
+
+var TestCurrenciesBySymbolData = []struct {
+	Symbol string
+	Output []string
+}{
+	{"?", []string{}},
+	{"€", []string{"EUR"}},
+	{"¥", []string{"JPY", "CNY"}},
+	{"kr", []string{"ISK", "NOK", "SEK"}},
+	{"£", []string{"GBP", "EGP", "FKP", "GIP", "SHP", "SSP", "SYP"}},
+}
+
+func TestCurrenciesBySymbol(t *testing.T) {
+	for _, v := range TestCurrenciesBySymbolData {
+		result := alphaCodes(CurrenciesBySymbol(v.Symbol))
+		if !reflect.DeepEqual(result, v.Output) {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+func TestSymbolPreference(t *testing.T) {
+	defer SetSymbolPreference("$")
+
+	if err := SetSymbolPreference("$", "CAD"); err != ErrorInvalidSymbol {
+		t.Error("Expected:", ErrorInvalidSymbol, "Got:", err)
+	}
+	if err := SetSymbolPreference("$", "USA"); err != ErrorInvalidISO {
+		t.Error("Expected:", ErrorInvalidISO, "Got:", err)
+	}
+	if err := SetSymbolPreference("$", "mxn", "AUD"); err != nil {
+		t.Error(err)
+	}
+	result := alphaCodes(CurrenciesBySymbol("$"))
+	if len(result) != 24 || !reflect.DeepEqual(result[:4], []string{"MXN", "AUD", "USD", "ARS"}) {
+		t.Error("Expected:", "MXN AUD USD ARS ...", "Got:", result)
+	}
+	ISO, err := PreferredCurrencyForSymbol("$")
+	if err != nil || ISO.Alpha != "MXN" {
+		t.Error("Expected:", "MXN", "Got:", ISO.Alpha, err)
+	}
+	m, err := ParseMoney("$5")
+	if err != nil || m.Alpha() != "MXN" {
+		t.Error("Expected:", "MXN", "Got:", m.Alpha(), err)
+	}
+
+	SetSymbolPreference("$")
+	ISO, err = PreferredCurrencyForSymbol("$")
+	if err != nil || ISO.Alpha != "USD" {
+		t.Error("Expected:", "USD", "Got:", ISO.Alpha, err)
+	}
+	if _, err := PreferredCurrencyForSymbol("?"); err != ErrorInvalidSymbol {
+		t.Error("Expected:", ErrorInvalidSymbol, "Got:", err)
+	}
+}
+
+func TestAmbiguousSymbols(t *testing.T) {
+	ambiguous := AmbiguousSymbols()
+	if len(ambiguous) != 13 || !reflect.DeepEqual(ambiguous["¥"], []string{"JPY", "CNY"}) {
+		t.Error("Expected:", "13 symbols with ¥ as JPY CNY", "Got:", ambiguous)
+	}
+	if !IsAmbiguousSymbol("$") || IsAmbiguousSymbol("€") || IsAmbiguousSymbol("?") {
+		t.Error("Expected:", "$ ambiguous, € and ? not")
+	}
+}
+
+// alphaCodes returns the alpha codes of a list of currencies
+func alphaCodes(list []Currency) []string {
+	output := []string{}
+	for _, ISO := range list {
+		output = append(output, ISO.Alpha)
+	}
+	return output
+}
@@ -0,0 +1,175 @@
+package dough
+
+import (
+	"math/big"
+)
+
+type roundingPoint string
+
+// Rounding points control when ConvertVia rounds to minor units
+const (
+	RoundFinal  roundingPoint = "final"   // multiply the rates exactly and round once to the target currency
+	RoundPerLeg roundingPoint = "per-leg" // round to the minor units of the pivot currency before the second leg
+)
+
+// rateLeg - a rate from a provider and whether it is applied inverted
+type rateLeg struct {
+	rate    Rate
+	inverse bool
+}
+
+// ratio : returns the exact ratio the leg multiplies amounts by
+func (l rateLeg) ratio() *big.Rat {
+	r := new(big.Rat).SetFrac(new(big.Int).Set(l.rate.Value.int()), pow10(l.rate.Value.Scale()))
+	if l.inverse {
+		r.Inv(r)
+	}
+	return r
+}
+
+// ConvertVia : returns an amount in minor units converted to another currency with the provider rates used, in path order
+//
+// The provider is asked for a direct rate, then the inverse rate, then for rates through the pivot
+// currency in either direction, so THB to PLN through USD can use the USD/THB and USD/PLN quotes.
+// The rates are multiplied exactly and rounded based upon the round mode at the rounding point.
+// ErrorNoRatePath is returned when none of these rates are available.
+func ConvertVia(amount int, from string, to string, pivot string, provider ExchangeRateProvider, mode round, point roundingPoint) (int, []Rate, error) {
+	result, rates, _, err := convertVia(int64(amount), from, to, pivot, provider, mode, point)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !fitsInt(result) {
+		return 0, nil, ErrorArithmeticOverflow
+	}
+	return int(result.Int64()), rates, nil
+}
+
+// ConvertVia : returns the money converted to another currency with the provider rates used, see ConvertVia
+func (m Money) ConvertVia(to string, pivot string, provider ExchangeRateProvider, mode round, point roundingPoint) (Money, []Rate, error) {
+	result, rates, ISO, err := convertVia(m.amount, m.currency.Alpha, to, pivot, provider, mode, point)
+	if err != nil {
+		return Money{}, nil, err
+	}
+	if !result.IsInt64() {
+		return Money{}, nil, ErrorArithmeticOverflow
+	}
+	return Money{amount: result.Int64(), currency: ISO}, rates, nil
+}
+
+// CrossRate : returns the rate from one currency to another through the pivot, rounded to scale digits based upon the round mode
+//
+// The timestamp is the oldest of the rates used, since the cross rate is only as current as that quote.
+func CrossRate(from string, to string, pivot string, provider ExchangeRateProvider, scale int, mode round) (Rate, error) {
+	fromISO, toISO, pivotISO, err := viaCurrencies(from, to, pivot)
+	if err != nil {
+		return Rate{}, err
+	}
+	legs, err := ratePath(fromISO.Alpha, toISO.Alpha, pivotISO.Alpha, provider)
+	if err != nil {
+		return Rate{}, err
+	}
+
+	cross := big.NewRat(1, 1)
+	rate := Rate{From: fromISO.Alpha, To: toISO.Alpha}
+	for key, leg := range legs {
+		cross.Mul(cross, leg.ratio())
+		if key == 0 || leg.rate.Timestamp.Before(rate.Timestamp) {
+			rate.Timestamp = leg.rate.Timestamp
+		}
+	}
+	scale = wholeScale(scale)
+	rate.Value = newDecimal(roundRat(cross, scale, mode), scale)
+	return rate, nil
+}
+
+// convertVia : returns the converted amount in minor units, the provider rates used and the currency converted to
+func convertVia(amount int64, from string, to string, pivot string, provider ExchangeRateProvider, mode round, point roundingPoint) (*big.Int, []Rate, Currency, error) {
+	fromISO, toISO, pivotISO, err := viaCurrencies(from, to, pivot)
+	if err != nil {
+		return nil, nil, Currency{}, err
+	}
+	legs, err := ratePath(fromISO.Alpha, toISO.Alpha, pivotISO.Alpha, provider)
+	if err != nil {
+		return nil, nil, Currency{}, err
+	}
+
+	value := new(big.Rat).SetFrac(big.NewInt(amount), pow10(fromISO.MinorDigits()))
+	rates := make([]Rate, 0, len(legs))
+	for key, leg := range legs {
+		value.Mul(value, leg.ratio())
+		rates = append(rates, leg.rate)
+		if point == RoundPerLeg && key < len(legs)-1 {
+			value.SetFrac(roundRat(value, pivotISO.MinorDigits(), mode), pow10(pivotISO.MinorDigits()))
+		}
+	}
+	return roundRat(value, toISO.MinorDigits(), mode), rates, toISO, nil
+}
+
+// viaCurrencies : returns the currencies of a conversion through a pivot
+func viaCurrencies(from string, to string, pivot string) (Currency, Currency, Currency, error) {
+	fromISO, err := GetISOFromAlpha(from)
+	if err != nil {
+		return Currency{}, Currency{}, Currency{}, err
+	}
+	toISO, err := GetISOFromAlpha(to)
+	if err != nil {
+		return Currency{}, Currency{}, Currency{}, err
+	}
+	pivotISO, err := GetISOFromAlpha(pivot)
+	if err != nil {
+		return Currency{}, Currency{}, Currency{}, err
+	}
+	return fromISO, toISO, pivotISO, nil
+}
+
+// ratePath : returns the legs from one currency to another, directly or through the pivot
+func ratePath(from string, to string, pivot string, provider ExchangeRateProvider) ([]rateLeg, error) {
+	if from == to {
+		return []rateLeg{}, nil
+	}
+	leg, err := findLeg(from, to, provider)
+	if err == nil {
+		return []rateLeg{leg}, nil
+	}
+	if err != ErrorRateNotFound || from == pivot || to == pivot {
+		return nil, noRatePath(err)
+	}
+
+	first, err := findLeg(from, pivot, provider)
+	if err != nil {
+		return nil, noRatePath(err)
+	}
+	second, err := findLeg(pivot, to, provider)
+	if err != nil {
+		return nil, noRatePath(err)
+	}
+	return []rateLeg{first, second}, nil
+}
+
+// findLeg : returns the rate from one currency to another, or the inverse of the rate the other way
+func findLeg(from string, to string, provider ExchangeRateProvider) (rateLeg, error) {
+	leg := rateLeg{}
+	rate, err := providerRate(provider, from, to)
+	if err == ErrorRateNotFound {
+		leg.inverse = true
+		rate, err = providerRate(provider, to, from)
+	}
+	if err != nil {
+		return rateLeg{}, err
+	}
+	leg.rate = rate
+	return leg, nil
+}
+
+// noRatePath : returns ErrorNoRatePath in place of ErrorRateNotFound, keeping any other provider error
+func noRatePath(err error) error {
+	if err == ErrorRateNotFound {
+		return ErrorNoRatePath
+	}
+	return err
+}
+
+// roundRat : returns a ratio as a count of 10^-scale units, rounded based upon the round mode
+func roundRat(r *big.Rat, scale int, mode round) *big.Int {
+	return divRound(new(big.Int).Mul(r.Num(), pow10(scale)), r.Denom(), mode)
+}
@@ -0,0 +1,124 @@
+package dough
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// pivotRates returns a StaticRateProvider publishing only USD and EUR pairs
+func pivotRates(t *testing.T) *StaticRateProvider {
+	p := NewStaticRateProvider()
+	for _, v := range []struct {
+		From  string
+		To    string
+		Value string
+		Time  time.Time
+	}{
+		{"USD", "THB", "36.75", quoted},
+		{"USD", "PLN", "4.0234", quoted.Add(-time.Hour)},
+		{"USD", "JPY", "159.72", quoted},
+		{"EUR", "USD", "1.0852", quoted},
+		{"EUR", "CHF", "0.9571", quoted},
+	} {
+		rate, err := ParseDecimal(v.Value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := p.SetRate(v.From, v.To, rate, v.Time); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return p
+}
+
+var TestConvertViaData = []struct {
+	Amount int
+	From   string
+	To     string
+	Pivot  string
+	Round  round
+	Point  roundingPoint
+	Output interface{}
+}{
+	{10000, "THB", "PLN", "USD", Round, RoundFinal, 1095},
+	{10000, "THB", "PLN", "USD", Round, RoundPerLeg, 1094},
+	{10000, "THB", "PLN", "USD", Floor, RoundFinal, 1094},
+	{-10000, "THB", "PLN", "USD", Round, RoundFinal, -1095},
+	{1000, "USD", "THB", "EUR", Round, RoundFinal, 36750},
+	{36750, "THB", "USD", "EUR", Round, RoundFinal, 1000},
+	{1000, "JPY", "THB", "USD", Round, RoundFinal, 23009},
+	{1000, "CHF", "USD", "EUR", Round, RoundFinal, 1134},
+	{1000, "CHF", "USD", "EUR", Round, RoundPerLeg, 1134},
+	{500, "PLN", "PLN", "USD", Round, RoundFinal, 500},
+	{1000, "THB", "CHF", "USD", Round, RoundFinal, ErrorNoRatePath.Error()},
+	{1000, "THB", "GBP", "USD", Round, RoundFinal, ErrorNoRatePath.Error()},
+	{1000, "THB", "PLN", "USA", Round, RoundFinal, ErrorInvalidISO.Error()},
+	{maxInt, "USD", "JPY", "EUR", Round, RoundFinal, ErrorArithmeticOverflow.Error()},
+}
+
+func TestConvertVia(t *testing.T) {
+	p := pivotRates(t)
+	for _, v := range TestConvertViaData {
+		result, _, err := ConvertVia(v.Amount, v.From, v.To, v.Pivot, p, v.Round, v.Point)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error("Expected:", v.Output, "Got:", err)
+			}
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result, v)
+		}
+	}
+
+	_, rates, _ := ConvertVia(10000, "THB", "PLN", "USD", p, Round, RoundFinal)
+	if len(rates) != 2 || rates[0].From != "USD" || rates[0].To != "THB" || rates[1].To != "PLN" {
+		t.Error("Expected:", "USD/THB then USD/PLN", "Got:", rates)
+	}
+}
+
+func TestMoneyConvertVia(t *testing.T) {
+	p := pivotRates(t)
+	m, _ := NewMoney(10000, "THB")
+	result, _, err := m.ConvertVia("PLN", "USD", p, Round, RoundPerLeg)
+	if err != nil || result.DisplayWithAlpha() != "PLN 10.94" {
+		t.Error("Expected:", "PLN 10.94", "Got:", result.DisplayWithAlpha(), err)
+	}
+
+	m, _ = NewMoney(math.MaxInt64, "USD")
+	if _, _, err := m.ConvertVia("JPY", "EUR", p, Round, RoundFinal); err != ErrorArithmeticOverflow {
+		t.Error("Expected:", ErrorArithmeticOverflow, "Got:", err)
+	}
+}
+
+// failingProvider returns an error other than ErrorRateNotFound
+type failingProvider struct{}
+
+func (failingProvider) Rate(from string, to string) (Rate, error) {
+	return Rate{}, errors.New("Feed Unavailable")
+}
+
+func TestConvertViaProviderError(t *testing.T) {
+	if _, _, err := ConvertVia(1000, "THB", "PLN", "USD", failingProvider{}, Round, RoundFinal); err == nil || err.Error() != "Feed Unavailable" {
+		t.Error("Expected:", "Feed Unavailable", "Got:", err)
+	}
+}
+
+func TestCrossRate(t *testing.T) {
+	p := pivotRates(t)
+	rate, err := CrossRate("THB", "PLN", "USD", p, 6, Round)
+	if err != nil || rate.Value.String() != "0.109480" || rate.From != "THB" || rate.To != "PLN" {
+		t.Error("Expected:", "THB/PLN 0.109480", "Got:", rate, err)
+	}
+	if !rate.Timestamp.Equal(quoted.Add(-time.Hour)) {
+		t.Error("Expected:", quoted.Add(-time.Hour), "Got:", rate.Timestamp)
+	}
+
+	rate, err = CrossRate("USD", "EUR", "USD", p, 4, Bankers)
+	if err != nil || rate.Value.String() != "0.9215" {
+		t.Error("Expected:", "0.9215", "Got:", rate, err)
+	}
+	if _, err := CrossRate("THB", "CHF", "USD", p, 6, Round); err != ErrorNoRatePath {
+		t.Error("Expected:", ErrorNoRatePath, "Got:", err)
+	}
+}
@@ -0,0 +1,124 @@
+package dough
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Speller - spells out an amount in one language, "major" and "minor" being the unsigned
+// whole units and minor units of the amount in the ISO currency
+type Speller interface {
+	Spell(major uint64, minor uint64, isNegative bool, ISO Currency) string
+}
+
+// spellers - registered spellers keyed by lower case language
+var spellers = struct {
+	sync.RWMutex
+	list map[string]Speller
+}{list: map[string]Speller{"en": englishSpeller{}}}
+
+// RegisterSpeller : registers a Speller for a language such as "es", replacing any speller already registered for it
+func RegisterSpeller(language string, speller Speller) {
+	spellers.Lock()
+	defer spellers.Unlock()
+	spellers.list[strings.ToLower(language)] = speller
+}
+
+// GetSpeller : returns the Speller registered for a language
+func GetSpeller(language string) (Speller, error) {
+	spellers.RLock()
+	defer spellers.RUnlock()
+	speller, ok := spellers.list[strings.ToLower(language)]
+	if !ok {
+		return nil, ErrorInvalidLanguage
+	}
+	return speller, nil
+}
+
+// AmountInWords : returns the amount spelled out for check printing... "num" being the amount, "alpha" being the ISO three digit alphabetic code, "language" being a registered speller language such as "en".
+func AmountInWords(num int, alpha string, language string) (string, error) {
+	ISO, err := GetISOFromAlpha(alpha)
+	if err != nil {
+		return "", err
+	}
+	speller, err := GetSpeller(language)
+	if err != nil {
+		return "", err
+	}
+
+	abs := uint64(num)
+	if num < 0 {
+		abs = -abs
+	}
+	unit := uint64(1)
+	for key := 0; key < ISO.Fraction; key++ {
+		unit *= 10
+	}
+	return speller.Spell(abs/unit, abs%unit, num < 0, ISO), nil
+}
+
+// englishSpeller - the built in English speller, "One thousand two hundred thirty-four and 56/100 US Dollar"
+type englishSpeller struct{}
+
+var englishOnes = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+	"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+
+var englishTens = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+
+var englishScales = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+
+// Spell : returns the amount in English with the minor units written as a fraction
+func (englishSpeller) Spell(major uint64, minor uint64, isNegative bool, ISO Currency) string {
+	str := englishNumber(major)
+	if isNegative {
+		str = "minus " + str
+	}
+	if ISO.Fraction > 0 {
+		digits := strconv.FormatUint(minor, 10)
+		if len(digits) < ISO.Fraction {
+			digits = strings.Repeat("0", ISO.Fraction-len(digits)) + digits
+		}
+		str += " and " + digits + "/1" + strings.Repeat("0", ISO.Fraction)
+	}
+	return strings.ToUpper(str[:1]) + str[1:] + " " + ISO.Unit
+}
+
+// englishNumber : returns a whole number in lower case English words
+func englishNumber(num uint64) string {
+	if num == 0 {
+		return englishOnes[0]
+	}
+	words := []string{}
+	for scale := 0; num > 0; scale++ {
+		group := num % 1000
+		num /= 1000
+		if group == 0 {
+			continue
+		}
+		str := englishHundreds(group)
+		if englishScales[scale] != "" {
+			str += " " + englishScales[scale]
+		}
+		words = append([]string{str}, words...)
+	}
+	return strings.Join(words, " ")
+}
+
+// englishHundreds : returns a number below one thousand in lower case English words
+func englishHundreds(num uint64) string {
+	words := []string{}
+	if num >= 100 {
+		words = append(words, englishOnes[num/100], "hundred")
+		num %= 100
+	}
+	switch {
+	case num >= 20 && num%10 != 0:
+		words = append(words, englishTens[num/10]+"-"+englishOnes[num%10])
+	case num >= 20:
+		words = append(words, englishTens[num/10])
+	case num > 0:
+		words = append(words, englishOnes[num])
+	}
+	return strings.Join(words, " ")
+}
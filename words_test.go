@@ -0,0 +1,58 @@
+package dough
+
+import (
+	"strconv"
+	"testing"
+)
+
+var TestAmountInWordsData = []struct {
+	Num      int64
+	Alpha    string
+	Language string
+	Output   string
+}{
+	{0, "USA", "en", ErrorInvalidISO.Error()},
+	{0, "USD", "xx", ErrorInvalidLanguage.Error()},
+	{0, "USD", "en", "Zero and 00/100 US Dollar"},
+	{5, "USD", "en", "Zero and 05/100 US Dollar"},
+	{123456, "USD", "en", "One thousand two hundred thirty-four and 56/100 US Dollar"},
+	{123456, "USD", "EN", "One thousand two hundred thirty-four and 56/100 US Dollar"},
+	{-2000, "USD", "en", "Minus twenty and 00/100 US Dollar"},
+	{1100000000, "USD", "en", "Eleven million and 00/100 US Dollar"},
+	{100001500, "EUR", "en", "One million fifteen and 00/100 Euro"},
+	{1234, "JPY", "en", "One thousand two hundred thirty-four Yen"},
+	{1500, "BHD", "en", "One and 500/1000 Bahraini Dinar"},
+	{-9223372036854775808, "JPY", "en", "Minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight Yen"},
+}
+
+func TestAmountInWords(t *testing.T) {
+	for _, v := range TestAmountInWordsData {
+		// Amounts past the range of int are only tested where int is 64 bits
+		if int64(int(v.Num)) != v.Num {
+			continue
+		}
+		result, err := AmountInWords(int(v.Num), v.Alpha, v.Language)
+		if err != nil {
+			if err.Error() != v.Output {
+				t.Error(err)
+			}
+		} else if result != v.Output {
+			t.Error("Expected:", v.Output, "Got:", result)
+		}
+	}
+}
+
+// testSpeller spells amounts as digits to check registration
+type testSpeller struct{}
+
+func (testSpeller) Spell(major uint64, minor uint64, isNegative bool, ISO Currency) string {
+	return strconv.FormatUint(major, 10) + " " + ISO.Alpha + " " + strconv.FormatUint(minor, 10)
+}
+
+func TestRegisterSpeller(t *testing.T) {
+	RegisterSpeller("Test", testSpeller{})
+	result, err := AmountInWords(123456, "EUR", "test")
+	if err != nil || result != "1234 EUR 56" {
+		t.Error("Expected:", "1234 EUR 56", "Got:", result, err)
+	}
+}